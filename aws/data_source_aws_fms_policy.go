@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsFmsPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"resource_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	name := d.Get("name").(string)
+	input := &fms.ListPoliciesInput{}
+
+	var policyIDs []string
+	for {
+		log.Printf("[DEBUG] Reading Firewall Manager policies: %s", input)
+		output, err := conn.ListPolicies(input)
+		if err != nil {
+			return fmt.Errorf("error listing Firewall Manager policies: %s", err)
+		}
+
+		for _, summary := range output.PolicyList {
+			if aws.StringValue(summary.PolicyName) == name {
+				policyIDs = append(policyIDs, aws.StringValue(summary.PolicyId))
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	if len(policyIDs) == 0 {
+		return fmt.Errorf("no Firewall Manager policy found with name %q", name)
+	}
+	if len(policyIDs) > 1 {
+		return fmt.Errorf("multiple Firewall Manager policies found with name %q", name)
+	}
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(policyIDs[0]),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Firewall Manager policy (%s): %s", policyIDs[0], err)
+	}
+
+	d.SetId(policyIDs[0])
+	d.Set("arn", output.PolicyArn)
+	return flattenFmsPolicy(d, output.Policy)
+}
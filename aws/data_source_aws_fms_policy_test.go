@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsFmsPolicy_name(t *testing.T) {
+	resourceName := "aws_fms_policy.test"
+	dataSourceName := "data.aws_fms_policy.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsFmsPolicyConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "exclude_resource_tags", resourceName, "exclude_resource_tags"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "remediation_enabled", resourceName, "remediation_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_type", resourceName, "resource_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_service_policy_data.0.type", resourceName, "security_service_policy_data.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsFmsPolicyConfigName(rName string) string {
+	return testAccFmsPolicyConfig(rName, false) + `
+data "aws_fms_policy" "test" {
+  name = "${aws_fms_policy.test.name}"
+}
+`
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIamPolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_documents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "*",
+						},
+						"principal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"context": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamPolicyEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	var buf bytes.Buffer
+
+	var docs []*IAMPolicyDoc
+	for i, v := range d.Get("policy_documents").([]interface{}) {
+		doc, err := parseIAMPolicyDoc(v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing policy document %d: %s", i, err)
+		}
+		docs = append(docs, doc)
+		buf.WriteString(v.(string))
+	}
+
+	allAllowed := true
+	var results []map[string]interface{}
+	for i, v := range d.Get("request").([]interface{}) {
+		req := expandIamPolicyEvaluationRequest(v.(map[string]interface{}))
+
+		result, err := evaluateIAMPolicyDocs(docs, req)
+		if err != nil {
+			return fmt.Errorf("error evaluating request %d (%s on %s): %s", i, req.Action, req.Resource, err)
+		}
+
+		if result.Decision != iamPolicyEvaluationDecisionAllowed {
+			allAllowed = false
+		}
+
+		results = append(results, map[string]interface{}{
+			"action":      req.Action,
+			"resource":    req.Resource,
+			"decision":    result.Decision,
+			"matched_sid": result.MatchedSid,
+		})
+		buf.WriteString(fmt.Sprintf("%s-%s-%s-%s-%v-", req.Action, req.Resource, req.PrincipalType, req.PrincipalIdentifier, req.Context))
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting results: %s", err)
+	}
+	d.Set("all_allowed", allAllowed)
+	d.SetId(strconv.Itoa(hashcode.String(buf.String())))
+
+	return nil
+}
+
+func expandIamPolicyEvaluationRequest(m map[string]interface{}) *IAMPolicyEvaluationRequest {
+	req := &IAMPolicyEvaluationRequest{
+		Action:   m["action"].(string),
+		Resource: m["resource"].(string),
+		Context:  map[string][]string{},
+	}
+
+	if v := m["principal"].([]interface{}); len(v) > 0 && v[0] != nil {
+		principal := v[0].(map[string]interface{})
+		req.PrincipalType = principal["type"].(string)
+		req.PrincipalIdentifier = principal["identifier"].(string)
+	}
+
+	for _, v := range m["context"].([]interface{}) {
+		entry := v.(map[string]interface{})
+		key := entry["key"].(string)
+		for _, value := range entry["values"].([]interface{}) {
+			req.Context[key] = append(req.Context[key], value.(string))
+		}
+	}
+
+	return req
+}
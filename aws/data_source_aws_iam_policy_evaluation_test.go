@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicyEvaluation_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	// This really ought to be able to be a unit test rather than an
+	// acceptance test, but just instantiating the AWS provider requires
+	// some AWS API calls, and so this needs valid AWS credentials to work.
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.resource", "arn:aws:s3:::example/public/index.html"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_sid", "AllowRead"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_sid", "DenyPrivate"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.matched_sid", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.matched_sid", "AllowOwnPrefix"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSIAMPolicyEvaluationConfig = `
+data "aws_iam_policy_document" "read" {
+  statement {
+    sid       = "AllowRead"
+    actions   = ["s3:Get*"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+
+  statement {
+    sid       = "DenyPrivate"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["arn:aws:s3:::example/private/*"]
+  }
+}
+
+data "aws_iam_policy_document" "write" {
+  statement {
+    sid       = "AllowOwnPrefix"
+    actions   = ["s3:PutObject"]
+    resources = ["arn:aws:s3:::example/home/&{aws:username}/*"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["true"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  policy_documents = [
+    "${data.aws_iam_policy_document.read.json}",
+    "${data.aws_iam_policy_document.write.json}",
+  ]
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/public/index.html"
+  }
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/private/key"
+  }
+
+  request {
+    action   = "s3:DeleteObject"
+    resource = "arn:aws:s3:::example/public/index.html"
+  }
+
+  request {
+    action   = "s3:PutObject"
+    resource = "arn:aws:s3:::example/home/alice/notes.txt"
+
+    context {
+      key    = "aws:username"
+      values = ["alice"]
+    }
+
+    context {
+      key    = "aws:SecureTransport"
+      values = ["true"]
+    }
+  }
+}
+`
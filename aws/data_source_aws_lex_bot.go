@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexBotRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"intent": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intent_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"intent_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLexBotName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validateLexVersion,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(name),
+		VersionOrAlias: aws.String(d.Get("version").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Lex bot (%s): %s", name, err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", name),
+	}
+
+	d.SetId(name)
+	d.Set("arn", arn.String())
+	d.Set("checksum", output.Checksum)
+	d.Set("child_directed", output.ChildDirected)
+	d.Set("created_date", lexTimeToString(output.CreatedDate))
+	d.Set("description", output.Description)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", lexTimeToString(output.LastUpdatedDate))
+	d.Set("locale", output.Locale)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("version", output.Version)
+	d.Set("voice_id", output.VoiceId)
+
+	if err := d.Set("intent", flattenLexIntents(output.Intents)); err != nil {
+		return fmt.Errorf("error setting intent: %s", err)
+	}
+
+	return nil
+}
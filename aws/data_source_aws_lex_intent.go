@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexIntentRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLexName,
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validateLexVersion,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(name),
+		Version: aws.String(d.Get("version").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Lex intent (%s): %s", name, err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("intent:%s", name),
+	}
+
+	d.SetId(name)
+	d.Set("arn", arn.String())
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", lexTimeToString(output.CreatedDate))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", lexTimeToString(output.LastUpdatedDate))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	d.Set("version", output.Version)
+
+	if err := d.Set("sample_utterances", flattenStringList(output.SampleUtterances)); err != nil {
+		return fmt.Errorf("error setting sample_utterances: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsOrganizationsAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	var accounts []*organizations.Account
+	var err error
+
+	if v, ok := d.GetOk("parent_id"); ok {
+		parentId := v.(string)
+		log.Printf("[DEBUG] Reading Organizations Accounts for parent: %s", parentId)
+
+		input := &organizations.ListAccountsForParentInput{
+			ParentId: aws.String(parentId),
+		}
+		err = conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+			accounts = append(accounts, page.Accounts...)
+			return !lastPage
+		})
+		d.SetId(parentId)
+	} else {
+		log.Printf("[DEBUG] Reading Organizations Accounts")
+
+		err = conn.ListAccountsPages(&organizations.ListAccountsInput{}, func(page *organizations.ListAccountsOutput, lastPage bool) bool {
+			accounts = append(accounts, page.Accounts...)
+			return !lastPage
+		})
+		d.SetId(time.Now().UTC().String())
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Accounts: %s", err)
+	}
+
+	ids := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		ids = append(ids, aws.StringValue(account.Id))
+	}
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsAccounts_basic(t *testing.T) {
+	resourceName := "aws_organizations_organization.test"
+	dataSourceName := "data.aws_organizations_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsAccountsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_account_id", dataSourceName, "ids.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_account_arn", dataSourceName, "accounts.0.arn"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsOrganizationsAccountsConfig = `
+resource "aws_organizations_organization" "test" {}
+
+data "aws_organizations_accounts" "test" {
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+`
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnits_basic(t *testing.T) {
+	resourceName := "aws_organizations_organizational_unit.test"
+	dataSourceName := "data.aws_organizations_organizational_units.test"
+	name := fmt.Sprintf("tf_outest_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsOrganizationalUnitsConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "children.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "children.0.name"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "children.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "children.0.arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnitsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %q
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_organizational_units" "test" {
+  parent_id = "${aws_organizations_organizational_unit.test.parent_id}"
+}
+`, name)
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsWorkspacesBundle() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsWorkspacesBundleRead,
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "owner"},
+			},
+			"compute_type": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"bundle_id"},
+			},
+			"owner": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"bundle_id"},
+			},
+			"root_storage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"user_storage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsWorkspacesBundleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	bundleId, bundleIdOk := d.GetOk("bundle_id")
+	name, nameOk := d.GetOk("name")
+	if !bundleIdOk && !nameOk {
+		return fmt.Errorf("one of bundle_id or name must be set")
+	}
+
+	input := &workspaces.DescribeWorkspaceBundlesInput{}
+	if bundleIdOk {
+		input.BundleIds = aws.StringSlice([]string{bundleId.(string)})
+	}
+	if v, ok := d.GetOk("owner"); ok {
+		input.Owner = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading WorkSpaces Bundle: %s", input)
+	var bundle *workspaces.WorkspaceBundle
+	err := conn.DescribeWorkspaceBundlesPages(input, func(page *workspaces.DescribeWorkspaceBundlesOutput, lastPage bool) bool {
+		for _, b := range page.Bundles {
+			if nameOk && aws.StringValue(b.Name) != name.(string) {
+				continue
+			}
+			bundle = b
+			return false
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Bundle: %s", err)
+	}
+
+	if bundle == nil {
+		return fmt.Errorf("no WorkSpaces Bundle matched; change your search criteria and try again")
+	}
+
+	d.SetId(aws.StringValue(bundle.BundleId))
+	d.Set("bundle_id", bundle.BundleId)
+	d.Set("description", bundle.Description)
+	d.Set("name", bundle.Name)
+	d.Set("owner", bundle.Owner)
+
+	computeType := make([]interface{}, 0)
+	if bundle.ComputeType != nil {
+		computeType = append(computeType, map[string]interface{}{
+			"name": aws.StringValue(bundle.ComputeType.Name),
+		})
+	}
+	if err := d.Set("compute_type", computeType); err != nil {
+		return fmt.Errorf("error setting compute_type: %s", err)
+	}
+
+	rootStorage := make([]interface{}, 0)
+	if bundle.RootStorage != nil {
+		rootStorage = append(rootStorage, map[string]interface{}{
+			"capacity": aws.StringValue(bundle.RootStorage.Capacity),
+		})
+	}
+	if err := d.Set("root_storage", rootStorage); err != nil {
+		return fmt.Errorf("error setting root_storage: %s", err)
+	}
+
+	userStorage := make([]interface{}, 0)
+	if bundle.UserStorage != nil {
+		userStorage = append(userStorage, map[string]interface{}{
+			"capacity": aws.StringValue(bundle.UserStorage.Capacity),
+		})
+	}
+	if err := d.Set("user_storage", userStorage); err != nil {
+		return fmt.Errorf("error setting user_storage: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSWorkspacesBundleDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_workspaces_bundle.test"
+	byNameDataSourceName := "data.aws_workspaces_bundle.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWorkspacesBundleDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bundle_id", "wsb-b0s22j3d7"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_type.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_type.0.name", "PERFORMANCE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "owner", "AMAZON"),
+					resource.TestCheckResourceAttr(dataSourceName, "root_storage.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "root_storage.0.capacity"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_storage.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_storage.0.capacity"),
+					resource.TestCheckResourceAttrPair(byNameDataSourceName, "bundle_id", dataSourceName, "bundle_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSWorkspacesBundleDataSourceConfig_basic = `
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-b0s22j3d7"
+}
+
+data "aws_workspaces_bundle" "by_name" {
+  name  = "${data.aws_workspaces_bundle.test.name}"
+  owner = "${data.aws_workspaces_bundle.test.owner}"
+}
+`
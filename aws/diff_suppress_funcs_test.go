@@ -29,3 +29,26 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected suppressEquivalentJsonDiffs to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestSuppressEquivalentRFC3339TimeDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{"2018-03-01T00:00:00Z", "2018-03-01T00:00:00Z", true},
+		{"2018-03-01T00:00:00Z", "2018-03-01T00:00:00+00:00", true},
+		{"2018-03-01T00:00:00Z", "2018-03-01T02:00:00+02:00", true},
+		{"2018-03-01T00:00:00Z", "2018-03-01T01:00:00Z", false},
+		{"2018-03-01T00:00:00Z", "", false},
+		{"", "2018-03-01T00:00:00Z", false},
+	}
+
+	for _, tc := range cases {
+		if got := suppressEquivalentRFC3339TimeDiffs("", tc.Old, tc.New, d); got != tc.Suppress {
+			t.Errorf("suppressEquivalentRFC3339TimeDiffs(%q, %q) = %t, expected %t", tc.Old, tc.New, got, tc.Suppress)
+		}
+	}
+}
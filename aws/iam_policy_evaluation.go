@@ -0,0 +1,518 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Decisions returned by IAMPolicyEvaluationRequest evaluation, named after
+// the EvalDecision values of the IAM policy simulator.
+const (
+	iamPolicyEvaluationDecisionAllowed      = "allowed"
+	iamPolicyEvaluationDecisionExplicitDeny = "explicitDeny"
+	iamPolicyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+// IAMPolicyEvaluationRequest describes a request to evaluate offline
+// against a set of policy documents. Context keys are case-insensitive.
+type IAMPolicyEvaluationRequest struct {
+	Action              string
+	Resource            string
+	PrincipalType       string
+	PrincipalIdentifier string
+	Context             map[string][]string
+}
+
+// IAMPolicyEvaluationResult is the outcome of evaluating a request: the
+// decision and the Sid of the statement that decided it, if any.
+type IAMPolicyEvaluationResult struct {
+	Decision   string
+	MatchedSid string
+}
+
+// iamPolicyConditionOperators maps each supported condition operator, without
+// ForAllValues:/ForAnyValue: qualifier or IfExists suffix, to the function
+// comparing a policy value with a request value and whether the operator is
+// the negation of that comparison.
+var iamPolicyConditionOperators = map[string]struct {
+	match   func(policyValue, requestValue string) (bool, error)
+	negated bool
+}{
+	"StringEquals":              {iamPolicyConditionStringEquals, false},
+	"StringNotEquals":           {iamPolicyConditionStringEquals, true},
+	"StringEqualsIgnoreCase":    {iamPolicyConditionStringEqualsIgnoreCase, false},
+	"StringNotEqualsIgnoreCase": {iamPolicyConditionStringEqualsIgnoreCase, true},
+	"StringLike":                {iamPolicyConditionStringLike, false},
+	"StringNotLike":             {iamPolicyConditionStringLike, true},
+	"NumericEquals":             {iamPolicyConditionNumeric(func(c int) bool { return c == 0 }), false},
+	"NumericNotEquals":          {iamPolicyConditionNumeric(func(c int) bool { return c == 0 }), true},
+	"NumericLessThan":           {iamPolicyConditionNumeric(func(c int) bool { return c < 0 }), false},
+	"NumericLessThanEquals":     {iamPolicyConditionNumeric(func(c int) bool { return c <= 0 }), false},
+	"NumericGreaterThan":        {iamPolicyConditionNumeric(func(c int) bool { return c > 0 }), false},
+	"NumericGreaterThanEquals":  {iamPolicyConditionNumeric(func(c int) bool { return c >= 0 }), false},
+	"DateEquals":                {iamPolicyConditionDate(func(c int) bool { return c == 0 }), false},
+	"DateNotEquals":             {iamPolicyConditionDate(func(c int) bool { return c == 0 }), true},
+	"DateLessThan":              {iamPolicyConditionDate(func(c int) bool { return c < 0 }), false},
+	"DateLessThanEquals":        {iamPolicyConditionDate(func(c int) bool { return c <= 0 }), false},
+	"DateGreaterThan":           {iamPolicyConditionDate(func(c int) bool { return c > 0 }), false},
+	"DateGreaterThanEquals":     {iamPolicyConditionDate(func(c int) bool { return c >= 0 }), false},
+	"Bool":                      {iamPolicyConditionStringEqualsIgnoreCase, false},
+	"BinaryEquals":              {iamPolicyConditionStringEquals, false},
+	"IpAddress":                 {iamPolicyConditionIpAddress, false},
+	"NotIpAddress":              {iamPolicyConditionIpAddress, true},
+	"ArnEquals":                 {iamPolicyConditionArnLike, false},
+	"ArnNotEquals":              {iamPolicyConditionArnLike, true},
+	"ArnLike":                   {iamPolicyConditionArnLike, false},
+	"ArnNotLike":                {iamPolicyConditionArnLike, true},
+	"Null":                      {nil, false},
+}
+
+var iamPolicyVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// parseIAMPolicyDoc unmarshals a JSON policy document, accepting both a list
+// of statements and a single statement object.
+func parseIAMPolicyDoc(policy string) (*IAMPolicyDoc, error) {
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal([]byte(policy), doc); err == nil {
+		return doc, nil
+	}
+
+	single := &struct {
+		Version   string `json:",omitempty"`
+		Id        string `json:",omitempty"`
+		Statement *IAMPolicyStatement
+	}{}
+	if err := json.Unmarshal([]byte(policy), single); err != nil {
+		return nil, err
+	}
+
+	doc.Version = single.Version
+	doc.Id = single.Id
+	if single.Statement != nil {
+		doc.Statements = []*IAMPolicyStatement{single.Statement}
+	}
+
+	return doc, nil
+}
+
+// evaluateIAMPolicyDocs evaluates a request against the statements of all
+// the given documents: an explicit deny in any statement overrides any allow,
+// and a request no statement allows is implicitly denied.
+func evaluateIAMPolicyDocs(docs []*IAMPolicyDoc, req *IAMPolicyEvaluationRequest) (*IAMPolicyEvaluationResult, error) {
+	var allow *IAMPolicyStatement
+
+	for _, doc := range docs {
+		for _, stmt := range doc.Statements {
+			matched, err := iamPolicyStatementMatches(stmt, req)
+			if err != nil {
+				if stmt.Sid != "" {
+					return nil, fmt.Errorf("statement %q: %s", stmt.Sid, err)
+				}
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+
+			switch stmt.Effect {
+			case "Deny":
+				return &IAMPolicyEvaluationResult{
+					Decision:   iamPolicyEvaluationDecisionExplicitDeny,
+					MatchedSid: stmt.Sid,
+				}, nil
+			case "Allow":
+				if allow == nil {
+					allow = stmt
+				}
+			default:
+				return nil, fmt.Errorf("unsupported Effect %q", stmt.Effect)
+			}
+		}
+	}
+
+	if allow != nil {
+		return &IAMPolicyEvaluationResult{
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: allow.Sid,
+		}, nil
+	}
+
+	return &IAMPolicyEvaluationResult{
+		Decision: iamPolicyEvaluationDecisionImplicitDeny,
+	}, nil
+}
+
+func iamPolicyStatementMatches(stmt *IAMPolicyStatement, req *IAMPolicyEvaluationRequest) (bool, error) {
+	// Actions are case-insensitive
+	if stmt.Actions != nil {
+		if !iamPolicyAnyWildcardMatch(iamPolicyStringList(stmt.Actions), req.Action, true) {
+			return false, nil
+		}
+	} else if stmt.NotActions != nil {
+		if iamPolicyAnyWildcardMatch(iamPolicyStringList(stmt.NotActions), req.Action, true) {
+			return false, nil
+		}
+	}
+
+	// A statement without Resource or NotResource, as found in trust and
+	// other resource-based policies, applies to any resource
+	if stmt.Resources != nil {
+		resources := iamPolicySubstituteVariablesInList(iamPolicyStringList(stmt.Resources), req.Context)
+		if !iamPolicyAnyWildcardMatch(resources, req.Resource, false) {
+			return false, nil
+		}
+	} else if stmt.NotResources != nil {
+		resources := iamPolicySubstituteVariablesInList(iamPolicyStringList(stmt.NotResources), req.Context)
+		if iamPolicyAnyWildcardMatch(resources, req.Resource, false) {
+			return false, nil
+		}
+	}
+
+	if len(stmt.Principals) > 0 {
+		if !iamPolicyPrincipalSetMatches(stmt.Principals, req) {
+			return false, nil
+		}
+	} else if len(stmt.NotPrincipals) > 0 {
+		if iamPolicyPrincipalSetMatches(stmt.NotPrincipals, req) {
+			return false, nil
+		}
+	}
+
+	for _, c := range stmt.Conditions {
+		matched, err := iamPolicyConditionMatches(c, req.Context)
+		if err != nil {
+			return false, err
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func iamPolicyPrincipalSetMatches(ps IAMPolicyStatementPrincipalSet, req *IAMPolicyEvaluationRequest) bool {
+	for _, p := range ps {
+		for _, id := range iamPolicyStringList(p.Identifiers) {
+			if p.Type == "*" && id == "*" {
+				return true
+			}
+			if req.PrincipalIdentifier == "" || p.Type != req.PrincipalType {
+				continue
+			}
+			if id == "*" || id == req.PrincipalIdentifier {
+				return true
+			}
+			// An AWS account ID is shorthand for the root user of the account
+			if p.Type == "AWS" && "arn:aws:iam::"+id+":root" == req.PrincipalIdentifier {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// iamPolicyConditionMatches evaluates one condition key of a condition block.
+// The policy values are ORed, and a multi-valued request key is evaluated
+// according to the ForAllValues:/ForAnyValue: qualifier of the operator.
+func iamPolicyConditionMatches(c IAMPolicyStatementCondition, context map[string][]string) (bool, error) {
+	op, forAll, forAny, ifExists := parseIAMPolicyConditionOperator(c.Test)
+
+	operator, ok := iamPolicyConditionOperators[op]
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator %q", c.Test)
+	}
+
+	policyValues := iamPolicySubstituteVariablesInList(iamPolicyStringList(c.Values), context)
+	requestValues := iamPolicyContextValues(context, c.Variable)
+
+	if op == "Null" {
+		for _, v := range policyValues {
+			if strings.EqualFold(v, "true") == (len(requestValues) == 0) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	if len(requestValues) == 0 {
+		return ifExists || forAll || (operator.negated && !forAny), nil
+	}
+
+	// matches reports whether a request value matches any policy value,
+	// taking the negation of the operator into account
+	matches := func(requestValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			ok, err := operator.match(policyValue, requestValue)
+			if err != nil {
+				return false, fmt.Errorf("condition %s on %q: %s", c.Test, c.Variable, err)
+			}
+			if ok {
+				return !operator.negated, nil
+			}
+		}
+		return operator.negated, nil
+	}
+
+	if forAll {
+		for _, v := range requestValues {
+			ok, err := matches(v)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	if forAny || !operator.negated {
+		for _, v := range requestValues {
+			ok, err := matches(v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+
+	// Without qualifier, a negated operator matches when none of the request
+	// values match a policy value
+	for _, v := range requestValues {
+		ok, err := matches(v)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// parseIAMPolicyConditionOperator splits a condition operator like
+// ForAnyValue:StringLikeIfExists into the base operator, StringLike, and its
+// set qualifier and IfExists suffix.
+func parseIAMPolicyConditionOperator(test string) (op string, forAll, forAny, ifExists bool) {
+	op = test
+	if strings.HasPrefix(op, "ForAllValues:") {
+		forAll = true
+		op = strings.TrimPrefix(op, "ForAllValues:")
+	} else if strings.HasPrefix(op, "ForAnyValue:") {
+		forAny = true
+		op = strings.TrimPrefix(op, "ForAnyValue:")
+	}
+	ifExists = strings.HasSuffix(op, "IfExists")
+	op = strings.TrimSuffix(op, "IfExists")
+
+	return
+}
+
+func iamPolicyConditionStringEquals(policyValue, requestValue string) (bool, error) {
+	return policyValue == requestValue, nil
+}
+
+func iamPolicyConditionStringEqualsIgnoreCase(policyValue, requestValue string) (bool, error) {
+	return strings.EqualFold(policyValue, requestValue), nil
+}
+
+func iamPolicyConditionStringLike(policyValue, requestValue string) (bool, error) {
+	return iamPolicyWildcardMatch(policyValue, requestValue, false), nil
+}
+
+func iamPolicyConditionNumeric(cmp func(int) bool) func(string, string) (bool, error) {
+	return func(policyValue, requestValue string) (bool, error) {
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid numeric value %q", policyValue)
+		}
+		r, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case r < p:
+			return cmp(-1), nil
+		case r > p:
+			return cmp(1), nil
+		}
+		return cmp(0), nil
+	}
+}
+
+func iamPolicyConditionDate(cmp func(int) bool) func(string, string) (bool, error) {
+	return func(policyValue, requestValue string) (bool, error) {
+		p, err := iamPolicyParseDate(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("invalid date value %q", policyValue)
+		}
+		r, err := iamPolicyParseDate(requestValue)
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case r.Before(p):
+			return cmp(-1), nil
+		case r.After(p):
+			return cmp(1), nil
+		}
+		return cmp(0), nil
+	}
+}
+
+// iamPolicyParseDate parses ISO 8601 dates and epoch times, the two date
+// formats accepted in IAM policies.
+func iamPolicyParseDate(v string) (time.Time, error) {
+	if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(epoch, 0), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", v)
+}
+
+func iamPolicyConditionIpAddress(policyValue, requestValue string) (bool, error) {
+	if !strings.Contains(policyValue, "/") {
+		if strings.Contains(policyValue, ":") {
+			policyValue += "/128"
+		} else {
+			policyValue += "/32"
+		}
+	}
+	_, cidr, err := net.ParseCIDR(policyValue)
+	if err != nil {
+		return false, fmt.Errorf("invalid IP address or CIDR block %q", policyValue)
+	}
+	ip := net.ParseIP(requestValue)
+	return ip != nil && cidr.Contains(ip), nil
+}
+
+// iamPolicyConditionArnLike matches each of the six colon-delimited parts of
+// an ARN separately, so that wildcards do not span parts.
+func iamPolicyConditionArnLike(policyValue, requestValue string) (bool, error) {
+	p := strings.SplitN(policyValue, ":", 6)
+	r := strings.SplitN(requestValue, ":", 6)
+	if len(p) != 6 {
+		return false, fmt.Errorf("invalid ARN %q", policyValue)
+	}
+	if len(r) != 6 {
+		return false, nil
+	}
+
+	for i := range p {
+		if !iamPolicyWildcardMatch(p[i], r[i], false) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func iamPolicyContextValues(context map[string][]string, key string) []string {
+	for k, v := range context {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+// iamPolicySubstituteVariablesInList replaces policy variables like
+// ${aws:username} with their value in the request context. Values referring
+// to a variable missing from the context, or with multiple values, are
+// dropped, as they cannot match anything.
+func iamPolicySubstituteVariablesInList(in []string, context map[string][]string) []string {
+	out := make([]string, 0, len(in))
+
+	for _, v := range in {
+		missing := false
+		v = iamPolicyVariableRegexp.ReplaceAllStringFunc(v, func(m string) string {
+			name := m[2 : len(m)-1]
+			switch name {
+			case "*", "?", "$":
+				return name
+			}
+			values := iamPolicyContextValues(context, name)
+			if len(values) != 1 {
+				missing = true
+				return m
+			}
+			return values[0]
+		})
+		if !missing {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func iamPolicyAnyWildcardMatch(patterns []string, value string, ignoreCase bool) bool {
+	for _, p := range patterns {
+		if iamPolicyWildcardMatch(p, value, ignoreCase) {
+			return true
+		}
+	}
+	return false
+}
+
+// iamPolicyWildcardMatch matches value against a pattern in which * matches
+// any sequence of characters and ? any single character.
+func iamPolicyWildcardMatch(pattern, value string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern = strings.ToLower(pattern)
+		value = strings.ToLower(value)
+	}
+
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	star, mark := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, vi
+			pi++
+		case star >= 0:
+			pi = star + 1
+			mark++
+			vi = mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+// iamPolicyStringList returns the values of a policy element, which is
+// either a single string or a list of strings.
+func iamPolicyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,370 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestIamPolicyWildcardMatch(t *testing.T) {
+	cases := []struct {
+		Pattern    string
+		Value      string
+		IgnoreCase bool
+		Expected   bool
+	}{
+		{"*", "s3:GetObject", false, true},
+		{"s3:Get*", "s3:GetObject", false, true},
+		{"s3:get*", "s3:GetObject", true, true},
+		{"s3:get*", "s3:GetObject", false, false},
+		{"s3:Get?bject", "s3:GetObject", false, true},
+		{"s3:Get?bject", "s3:GetObjectAcl", false, false},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/a/b", false, true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket", false, false},
+		{"arn:aws:s3:::*/key", "arn:aws:s3:::bucket/key", false, true},
+		{"a*b*c", "aXXbYYc", false, true},
+		{"a*b*c", "aXXbYY", false, false},
+		{"", "", false, true},
+	}
+
+	for _, tc := range cases {
+		if got := iamPolicyWildcardMatch(tc.Pattern, tc.Value, tc.IgnoreCase); got != tc.Expected {
+			t.Errorf("iamPolicyWildcardMatch(%q, %q, %t) = %t, expected %t", tc.Pattern, tc.Value, tc.IgnoreCase, got, tc.Expected)
+		}
+	}
+}
+
+func TestIamPolicyConditionMatches(t *testing.T) {
+	cases := map[string]struct {
+		Condition IAMPolicyStatementCondition
+		Context   map[string][]string
+		Expected  bool
+		ExpectErr bool
+	}{
+		"StringEquals match": {
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:username", Values: []string{"alice", "bob"}},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			Expected:  true,
+		},
+		"StringEquals case-insensitive key": {
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:UserName", Values: "bob"},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			Expected:  true,
+		},
+		"StringEquals missing key": {
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:username", Values: []string{"bob"}},
+			Context:   map[string][]string{},
+			Expected:  false,
+		},
+		"StringEqualsIfExists missing key": {
+			Condition: IAMPolicyStatementCondition{Test: "StringEqualsIfExists", Variable: "aws:username", Values: []string{"bob"}},
+			Context:   map[string][]string{},
+			Expected:  true,
+		},
+		"StringNotEquals missing key": {
+			Condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:username", Values: []string{"bob"}},
+			Context:   map[string][]string{},
+			Expected:  true,
+		},
+		"StringNotEquals match": {
+			Condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:username", Values: []string{"bob"}},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			Expected:  false,
+		},
+		"StringLike with variable": {
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/${aws:username}/*"}},
+			Context:   map[string][]string{"aws:username": {"bob"}, "s3:prefix": {"home/bob/photos"}},
+			Expected:  true,
+		},
+		"StringLike with other user variable": {
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/${aws:username}/*"}},
+			Context:   map[string][]string{"aws:username": {"alice"}, "s3:prefix": {"home/bob/photos"}},
+			Expected:  false,
+		},
+		"Bool": {
+			Condition: IAMPolicyStatementCondition{Test: "Bool", Variable: "aws:SecureTransport", Values: []string{"false"}},
+			Context:   map[string][]string{"aws:SecureTransport": {"False"}},
+			Expected:  true,
+		},
+		"NumericLessThanEquals": {
+			Condition: IAMPolicyStatementCondition{Test: "NumericLessThanEquals", Variable: "s3:max-keys", Values: []string{"10"}},
+			Context:   map[string][]string{"s3:max-keys": {"10"}},
+			Expected:  true,
+		},
+		"NumericGreaterThan": {
+			Condition: IAMPolicyStatementCondition{Test: "NumericGreaterThan", Variable: "s3:max-keys", Values: []string{"10"}},
+			Context:   map[string][]string{"s3:max-keys": {"10"}},
+			Expected:  false,
+		},
+		"NumericEquals invalid policy value": {
+			Condition: IAMPolicyStatementCondition{Test: "NumericEquals", Variable: "s3:max-keys", Values: []string{"ten"}},
+			Context:   map[string][]string{"s3:max-keys": {"10"}},
+			ExpectErr: true,
+		},
+		"DateLessThan": {
+			Condition: IAMPolicyStatementCondition{Test: "DateLessThan", Variable: "aws:CurrentTime", Values: []string{"2019-01-01T00:00:00Z"}},
+			Context:   map[string][]string{"aws:CurrentTime": {"2018-06-01T12:00:00Z"}},
+			Expected:  true,
+		},
+		"DateGreaterThan epoch": {
+			Condition: IAMPolicyStatementCondition{Test: "DateGreaterThan", Variable: "aws:EpochTime", Values: []string{"1546300800"}},
+			Context:   map[string][]string{"aws:EpochTime": {"2018-06-01T12:00:00Z"}},
+			Expected:  false,
+		},
+		"IpAddress": {
+			Condition: IAMPolicyStatementCondition{Test: "IpAddress", Variable: "aws:SourceIp", Values: []string{"203.0.113.0/24"}},
+			Context:   map[string][]string{"aws:SourceIp": {"203.0.113.10"}},
+			Expected:  true,
+		},
+		"NotIpAddress": {
+			Condition: IAMPolicyStatementCondition{Test: "NotIpAddress", Variable: "aws:SourceIp", Values: []string{"203.0.113.0/24", "198.51.100.1"}},
+			Context:   map[string][]string{"aws:SourceIp": {"198.51.100.1"}},
+			Expected:  false,
+		},
+		"ArnLike": {
+			Condition: IAMPolicyStatementCondition{Test: "ArnLike", Variable: "aws:SourceArn", Values: []string{"arn:aws:sns:*:123456789012:*"}},
+			Context:   map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}},
+			Expected:  true,
+		},
+		"ArnLike invalid policy ARN": {
+			Condition: IAMPolicyStatementCondition{Test: "ArnLike", Variable: "aws:SourceArn", Values: []string{"arn:aws:sns:*:topic"}},
+			Context:   map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}},
+			ExpectErr: true,
+		},
+		"Null true": {
+			Condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: []string{"true"}},
+			Context:   map[string][]string{},
+			Expected:  true,
+		},
+		"Null false": {
+			Condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: []string{"false"}},
+			Context:   map[string][]string{},
+			Expected:  false,
+		},
+		"ForAllValues all match": {
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"Name", "Owner"}},
+			Context:   map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			Expected:  true,
+		},
+		"ForAllValues one does not match": {
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"Name"}},
+			Context:   map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			Expected:  false,
+		},
+		"ForAllValues missing key": {
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"Name"}},
+			Context:   map[string][]string{},
+			Expected:  true,
+		},
+		"ForAnyValue one matches": {
+			Condition: IAMPolicyStatementCondition{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"Owner"}},
+			Context:   map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			Expected:  true,
+		},
+		"ForAnyValue missing key": {
+			Condition: IAMPolicyStatementCondition{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"Owner"}},
+			Context:   map[string][]string{},
+			Expected:  false,
+		},
+		"unsupported operator": {
+			Condition: IAMPolicyStatementCondition{Test: "StringSortOf", Variable: "aws:username", Values: []string{"bob"}},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			ExpectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		got, err := iamPolicyConditionMatches(tc.Condition, tc.Context)
+		if tc.ExpectErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got none", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", name, tc.Expected, got)
+		}
+	}
+}
+
+func TestEvaluateIAMPolicyDocs(t *testing.T) {
+	identityPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowRead",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": ["arn:aws:s3:::bucket", "arn:aws:s3:::bucket/*"]
+    },
+    {
+      "Sid": "DenySecrets",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "arn:aws:s3:::bucket/secrets/*"
+    },
+    {
+      "Sid": "DenyInsecure",
+      "Effect": "Deny",
+      "NotAction": "s3:List*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    },
+    {
+      "Sid": "AllowOwnPrefix",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "NotResource": "arn:aws:s3:::bucket/secrets/*",
+      "Condition": {"StringLike": {"s3:prefix": "home/${aws:username}/*"}}
+    }
+  ]
+}`
+
+	trustPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "AssumeFromAccount",
+    "Effect": "Allow",
+    "Action": "sts:AssumeRole",
+    "Principal": {"AWS": "123456789012"}
+  }
+}`
+
+	cases := map[string]struct {
+		Policies   []string
+		Request    *IAMPolicyEvaluationRequest
+		Decision   string
+		MatchedSid string
+	}{
+		"allowed": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/key",
+			},
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: "AllowRead",
+		},
+		"action is case-insensitive": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "S3:getobject",
+				Resource: "arn:aws:s3:::bucket/key",
+			},
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: "AllowRead",
+		},
+		"explicit deny overrides allow": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/secrets/key",
+			},
+			Decision:   iamPolicyEvaluationDecisionExplicitDeny,
+			MatchedSid: "DenySecrets",
+		},
+		"implicit deny": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:DeleteObject",
+				Resource: "arn:aws:s3:::bucket/key",
+			},
+			Decision: iamPolicyEvaluationDecisionImplicitDeny,
+		},
+		"condition with boolean value": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/key",
+				Context:  map[string][]string{"aws:SecureTransport": {"false"}},
+			},
+			Decision:   iamPolicyEvaluationDecisionExplicitDeny,
+			MatchedSid: "DenyInsecure",
+		},
+		"NotAction excludes the deny": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:ListBucket",
+				Resource: "arn:aws:s3:::bucket",
+				Context:  map[string][]string{"aws:SecureTransport": {"false"}},
+			},
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: "AllowRead",
+		},
+		"NotResource and policy variable": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:PutObject",
+				Resource: "arn:aws:s3:::bucket/home/bob/file",
+				Context:  map[string][]string{"aws:username": {"bob"}, "s3:prefix": {"home/bob/file"}},
+			},
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: "AllowOwnPrefix",
+		},
+		"policy variable missing from context": {
+			Policies: []string{identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:PutObject",
+				Resource: "arn:aws:s3:::bucket/home/bob/file",
+				Context:  map[string][]string{"s3:prefix": {"home/bob/file"}},
+			},
+			Decision: iamPolicyEvaluationDecisionImplicitDeny,
+		},
+		"principal account ID": {
+			Policies: []string{trustPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:              "sts:AssumeRole",
+				Resource:            "arn:aws:iam::123456789012:role/example",
+				PrincipalType:       "AWS",
+				PrincipalIdentifier: "arn:aws:iam::123456789012:root",
+			},
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: "AssumeFromAccount",
+		},
+		"principal from other account": {
+			Policies: []string{trustPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:              "sts:AssumeRole",
+				Resource:            "arn:aws:iam::123456789012:role/example",
+				PrincipalType:       "AWS",
+				PrincipalIdentifier: "arn:aws:iam::210987654321:root",
+			},
+			Decision: iamPolicyEvaluationDecisionImplicitDeny,
+		},
+		"multiple documents": {
+			Policies: []string{trustPolicy, identityPolicy},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:   "s3:ListBucket",
+				Resource: "arn:aws:s3:::bucket",
+			},
+			Decision:   iamPolicyEvaluationDecisionAllowed,
+			MatchedSid: "AllowRead",
+		},
+	}
+
+	for name, tc := range cases {
+		var docs []*IAMPolicyDoc
+		for _, policy := range tc.Policies {
+			doc, err := parseIAMPolicyDoc(policy)
+			if err != nil {
+				t.Fatalf("%s: error parsing policy: %s", name, err)
+			}
+			docs = append(docs, doc)
+		}
+
+		result, err := evaluateIAMPolicyDocs(docs, tc.Request)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+
+		if result.Decision != tc.Decision {
+			t.Errorf("%s: expected decision %q, got %q", name, tc.Decision, result.Decision)
+		}
+		if result.MatchedSid != tc.MatchedSid {
+			t.Errorf("%s: expected matched Sid %q, got %q", name, tc.MatchedSid, result.MatchedSid)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 )
 
 type IAMPolicyDoc struct {
@@ -178,14 +179,22 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	for test_key, test_value := range data {
 		for var_key, var_values := range test_value {
 			switch var_values.(type) {
-			case string:
-				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values.(string)}})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values.([]interface{}) {
-					values = append(values, v.(string))
+					value, err := iamPolicyConditionValueString(v)
+					if err != nil {
+						return err
+					}
+					values = append(values, value)
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+			default:
+				value, err := iamPolicyConditionValueString(var_values)
+				if err != nil {
+					return err
+				}
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{value}})
 			}
 		}
 	}
@@ -194,6 +203,21 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// iamPolicyConditionValueString returns condition values written as JSON
+// booleans or numbers, e.g. "aws:SecureTransport": false, as strings.
+func iamPolicyConditionValueString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("Unsupported data type %T for IAMPolicyStatementConditionSet.Values", v)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Maximum sizes of IAM policy documents, in characters excluding whitespace.
+// The inline policy limits apply to the total size of all the inline policies
+// of a user, group or role, so a document under the limit can still be
+// rejected by the API. The trust policy limit is the maximum a quota increase
+// allows; the default is 2,048 characters.
+const (
+	iamManagedPolicyMaxSize     = 6144
+	iamRoleInlinePolicyMaxSize  = 10240
+	iamGroupInlinePolicyMaxSize = 5120
+	iamUserInlinePolicyMaxSize  = 2048
+	iamAssumeRolePolicyMaxSize  = 4096
+)
+
+type iamPolicyType int
+
+const (
+	// Identity-based policies, attached to users, groups and roles
+	iamPolicyTypeIdentity iamPolicyType = iota
+	// Role trust policies, i.e. assume_role_policy
+	iamPolicyTypeTrust
+)
+
+var iamPolicySidRegexp = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+
+// customizeDiffValidateIAMPolicy returns a CustomizeDiffFunc validating the
+// policy document in the given attribute when it changes, so that invalid
+// documents are reported at plan time rather than rejected by the IAM API
+// during apply.
+func customizeDiffValidateIAMPolicy(key string, policyType iamPolicyType, maxSize int) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		policy := diff.Get(key).(string)
+		if policy == "" {
+			return nil
+		}
+
+		if err := validateIAMPolicyDocument(policy, policyType, maxSize); err != nil {
+			return fmt.Errorf("%q: %s", key, err)
+		}
+
+		return nil
+	}
+}
+
+// validateIAMPolicyDocument checks a JSON policy document against the IAM
+// policy grammar and size limit, returning an error describing the first
+// offending statement.
+func validateIAMPolicyDocument(policy string, policyType iamPolicyType, maxSize int) error {
+	var minified bytes.Buffer
+	if err := json.Compact(&minified, []byte(policy)); err != nil {
+		return fmt.Errorf("invalid JSON: %s", err)
+	}
+	if size := utf8.RuneCount(minified.Bytes()); size > maxSize {
+		return fmt.Errorf("policy document is %d characters long without whitespace, which exceeds the IAM limit of %d", size, maxSize)
+	}
+
+	doc, err := parseIAMPolicyDoc(policy)
+	if err != nil {
+		return fmt.Errorf("invalid policy document: %s", err)
+	}
+
+	switch doc.Version {
+	case "", "2008-10-17", "2012-10-17":
+	default:
+		return fmt.Errorf("invalid Version %q, must be \"2012-10-17\" or \"2008-10-17\"", doc.Version)
+	}
+
+	if len(doc.Statements) == 0 {
+		return fmt.Errorf("policy document must contain at least one statement")
+	}
+
+	for i, stmt := range doc.Statements {
+		if err := validateIAMPolicyStatement(stmt, policyType); err != nil {
+			if stmt.Sid != "" {
+				return fmt.Errorf("statement %d (Sid %q): %s", i+1, stmt.Sid, err)
+			}
+			return fmt.Errorf("statement %d: %s", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func validateIAMPolicyStatement(stmt *IAMPolicyStatement, policyType iamPolicyType) error {
+	if stmt == nil {
+		return fmt.Errorf("statement must be an object")
+	}
+
+	if !iamPolicySidRegexp.MatchString(stmt.Sid) {
+		return fmt.Errorf("Sid must only contain alphanumeric characters")
+	}
+
+	switch stmt.Effect {
+	case "Allow", "Deny":
+	case "":
+		return fmt.Errorf("Effect is required")
+	default:
+		return fmt.Errorf("invalid Effect %q, must be \"Allow\" or \"Deny\"", stmt.Effect)
+	}
+
+	if err := validateIAMPolicyElementPair("Action", stmt.Actions, "NotAction", stmt.NotActions); err != nil {
+		return err
+	}
+
+	hasPrincipal := len(stmt.Principals) > 0 || len(stmt.NotPrincipals) > 0
+	switch policyType {
+	case iamPolicyTypeIdentity:
+		if hasPrincipal {
+			return fmt.Errorf("Principal and NotPrincipal are not allowed in identity-based policies")
+		}
+		if err := validateIAMPolicyElementPair("Resource", stmt.Resources, "NotResource", stmt.NotResources); err != nil {
+			return err
+		}
+	case iamPolicyTypeTrust:
+		if !hasPrincipal {
+			return fmt.Errorf("one of Principal or NotPrincipal is required in trust policies")
+		}
+		if stmt.Resources != nil || stmt.NotResources != nil {
+			return fmt.Errorf("Resource and NotResource are not allowed in trust policies")
+		}
+	}
+
+	if len(stmt.Principals) > 0 && len(stmt.NotPrincipals) > 0 {
+		return fmt.Errorf("only one of Principal or NotPrincipal can be specified")
+	}
+
+	for _, c := range stmt.Conditions {
+		op, _, _, _ := parseIAMPolicyConditionOperator(c.Test)
+		if _, ok := iamPolicyConditionOperators[op]; !ok {
+			return fmt.Errorf("unknown condition operator %q", c.Test)
+		}
+	}
+
+	return nil
+}
+
+// validateIAMPolicyElementPair checks that exactly one of an element and its
+// Not counterpart, e.g. Action and NotAction, is specified, as a string or a
+// list of strings.
+func validateIAMPolicyElementPair(name string, v interface{}, notName string, notV interface{}) error {
+	switch {
+	case v != nil && notV != nil:
+		return fmt.Errorf("only one of %s or %s can be specified", name, notName)
+	case v != nil:
+		return validateIAMPolicyStringList(name, v)
+	case notV != nil:
+		return validateIAMPolicyStringList(notName, notV)
+	}
+
+	return fmt.Errorf("one of %s or %s is required", name, notName)
+}
+
+func validateIAMPolicyStringList(name string, v interface{}) error {
+	switch v := v.(type) {
+	case string:
+		return nil
+	case []interface{}:
+		for _, item := range v {
+			if _, ok := item.(string); !ok {
+				return fmt.Errorf("%s must be a string or a list of strings", name)
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s must be a string or a list of strings", name)
+}
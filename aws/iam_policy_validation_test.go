@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestValidateIAMPolicyDocument(t *testing.T) {
+	cases := map[string]struct {
+		Policy      string
+		Type        iamPolicyType
+		MaxSize     int
+		ExpectError string
+	}{
+		"valid identity policy": {
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadBucket1",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": "arn:aws:s3:::bucket/*",
+      "Condition": {"ForAnyValue:StringLikeIfExists": {"s3:prefix": "home/*"}, "Bool": {"aws:SecureTransport": true}}
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "s3:*",
+      "NotResource": "*"
+    }
+  ]
+}`,
+			Type:    iamPolicyTypeIdentity,
+			MaxSize: iamManagedPolicyMaxSize,
+		},
+		"valid single statement": {
+			Policy:  `{"Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*"}}`,
+			Type:    iamPolicyTypeIdentity,
+			MaxSize: iamManagedPolicyMaxSize,
+		},
+		"valid trust policy": {
+			Policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]},"Action":["sts:AssumeRole"]}]}`,
+			Type:    iamPolicyTypeTrust,
+			MaxSize: iamAssumeRolePolicyMaxSize,
+		},
+		"invalid JSON": {
+			Policy:      `{"Statement": [}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `invalid JSON`,
+		},
+		"invalid Version": {
+			Policy:      `{"Version": "2012-10-18", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `invalid Version "2012-10-18"`,
+		},
+		"no statements": {
+			Policy:      `{"Version": "2012-10-17", "Statement": []}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `at least one statement`,
+		},
+		"invalid Effect": {
+			Policy:      `{"Statement": [{"Sid": "A", "Effect": "Allow", "Action": "s3:*", "Resource": "*"}, {"Sid": "B", "Effect": "allow", "Action": "s3:*", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `statement 2 \(Sid "B"\): invalid Effect "allow"`,
+		},
+		"missing Effect": {
+			Policy:      `{"Statement": [{"Action": "s3:*", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `statement 1: Effect is required`,
+		},
+		"invalid Sid": {
+			Policy:      `{"Statement": [{"Sid": "read-bucket", "Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `Sid must only contain alphanumeric characters`,
+		},
+		"missing Action": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `one of Action or NotAction is required`,
+		},
+		"Action and NotAction": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "NotAction": "iam:*", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `only one of Action or NotAction can be specified`,
+		},
+		"invalid Action type": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": ["s3:*", 5], "Resource": "*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `Action must be a string or a list of strings`,
+		},
+		"missing Resource": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": "s3:*"}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `one of Resource or NotResource is required`,
+		},
+		"Principal in identity policy": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Principal": {"AWS": "123456789012"}}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `Principal and NotPrincipal are not allowed in identity-based policies`,
+		},
+		"missing Principal in trust policy": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole"}]}`,
+			Type:        iamPolicyTypeTrust,
+			MaxSize:     iamAssumeRolePolicyMaxSize,
+			ExpectError: `one of Principal or NotPrincipal is required in trust policies`,
+		},
+		"Resource in trust policy": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole", "Principal": "*", "Resource": "*"}]}`,
+			Type:        iamPolicyTypeTrust,
+			MaxSize:     iamAssumeRolePolicyMaxSize,
+			ExpectError: `Resource and NotResource are not allowed in trust policies`,
+		},
+		"unknown condition operator": {
+			Policy:      `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"StringEqual": {"aws:username": "bob"}}}]}`,
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamManagedPolicyMaxSize,
+			ExpectError: `unknown condition operator "StringEqual"`,
+		},
+		"too large": {
+			Policy:      fmt.Sprintf(`{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": %q}]}`, strings.Repeat("a", iamUserInlinePolicyMaxSize)),
+			Type:        iamPolicyTypeIdentity,
+			MaxSize:     iamUserInlinePolicyMaxSize,
+			ExpectError: `exceeds the IAM limit of 2048`,
+		},
+		"whitespace is not counted": {
+			Policy:  `{"Statement": [{"Effect": "Allow", "Action": "s3:*",` + strings.Repeat(" ", iamUserInlinePolicyMaxSize) + `"Resource": "*"}]}`,
+			Type:    iamPolicyTypeIdentity,
+			MaxSize: iamUserInlinePolicyMaxSize,
+		},
+	}
+
+	for name, tc := range cases {
+		err := validateIAMPolicyDocument(tc.Policy, tc.Type, tc.MaxSize)
+
+		if tc.ExpectError == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("%s: expected error matching %q, got none", name, tc.ExpectError)
+			continue
+		}
+		if !regexp.MustCompile(tc.ExpectError).MatchString(err.Error()) {
+			t.Errorf("%s: expected error matching %q, got: %s", name, tc.ExpectError, err)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// keyValueTags is the service independent representation of resource tags.
+// The per-service tagging helpers convert their SDK tag types to and from
+// keyValueTags, so that diffing, ignore rules, batching and retries behave
+// the same way for every service.
+type keyValueTags map[string]string
+
+// newKeyValueTags creates keyValueTags from the tag representations used by
+// Terraform and the AWS SDK: maps of strings, string pointers or interfaces,
+// and lists of tag keys.
+func newKeyValueTags(i interface{}) keyValueTags {
+	tags := make(keyValueTags)
+
+	switch value := i.(type) {
+	case keyValueTags:
+		for k, v := range value {
+			tags[k] = v
+		}
+	case map[string]string:
+		for k, v := range value {
+			tags[k] = v
+		}
+	case map[string]*string:
+		for k, v := range value {
+			tags[k] = aws.StringValue(v)
+		}
+	case map[string]interface{}:
+		for k, v := range value {
+			tags[k] = v.(string)
+		}
+	case []string:
+		for _, k := range value {
+			tags[k] = ""
+		}
+	case []*string:
+		for _, k := range value {
+			tags[aws.StringValue(k)] = ""
+		}
+	case []interface{}:
+		for _, k := range value {
+			tags[k.(string)] = ""
+		}
+	}
+
+	return tags
+}
+
+// IgnoreAws returns the tags without those reserved for use by AWS.
+func (tags keyValueTags) IgnoreAws() keyValueTags {
+	return tags.IgnoreConfig(nil)
+}
+
+// IgnoreConfig returns the tags without those matching the ignore
+// configuration, which always includes the tags reserved for use by AWS.
+func (tags keyValueTags) IgnoreConfig(c *tagIgnoreConfig) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if !c.ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns the sorted tag keys.
+func (tags keyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+	for k := range tags {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// Map returns the tags as a map of strings, as used for "tags" in state.
+func (tags keyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns the tags whose keys are no longer present in newTags.
+func (tags keyValueTags) Removed(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tags in newTags that are not present in, or have a
+// different value than, these tags.
+func (tags keyValueTags) Updated(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range newTags {
+		if old, ok := tags[k]; !ok || old != v {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Chunks splits the tags into groups of at most size tags, ordered by key.
+// A size of zero or less returns all tags in a single group.
+func (tags keyValueTags) Chunks(size int) []keyValueTags {
+	if len(tags) == 0 {
+		return nil
+	}
+	if size <= 0 {
+		return []keyValueTags{tags}
+	}
+
+	var result []keyValueTags
+	chunk := make(keyValueTags)
+	for _, k := range tags.Keys() {
+		if len(chunk) == size {
+			result = append(result, chunk)
+			chunk = make(keyValueTags)
+		}
+		chunk[k] = tags[k]
+	}
+
+	return append(result, chunk)
+}
+
+// tagsUpdater adapts a service's tagging API to the common update logic in
+// updateTags. Each service helper supplies the calls that add and remove tags
+// on a single resource along with the limits of that API.
+type tagsUpdater struct {
+	// tag adds the given tags to the resource, overwriting existing values.
+	tag func(tags keyValueTags) error
+
+	// untag removes the given tag keys from the resource.
+	untag func(tags keyValueTags) error
+
+	// replace sets the complete tag set of the resource, for APIs without
+	// separate add and remove calls. When set, tag and untag are not used.
+	replace func(tags keyValueTags) error
+
+	// batchSize is the maximum number of tags in a single tag or untag call.
+	// Zero means no limit.
+	batchSize int
+
+	// retryable reports whether a failed call should be retried, typically
+	// because a newly created resource is not yet visible to the tagging API.
+	retryable func(err error) bool
+}
+
+// tagsUpdaterRetryTimeout bounds retries on eventual consistency errors.
+const tagsUpdaterRetryTimeout = 5 * time.Minute
+
+// updateTags reconciles the tags of a resource from oldTags to newTags,
+// removing tags first. Tags reserved by AWS are never touched.
+func (u *tagsUpdater) updateTags(oldTags, newTags keyValueTags) error {
+	oldTags = oldTags.IgnoreAws()
+	newTags = newTags.IgnoreAws()
+
+	if u.replace != nil {
+		if len(oldTags.Removed(newTags)) == 0 && len(oldTags.Updated(newTags)) == 0 {
+			return nil
+		}
+		log.Printf("[DEBUG] Replacing tags with: %s", newTags.Keys())
+		return u.retry(func() error {
+			return u.replace(newTags)
+		})
+	}
+
+	for _, removed := range oldTags.Removed(newTags).Chunks(u.batchSize) {
+		log.Printf("[DEBUG] Removing tags: %s", removed.Keys())
+		if err := u.retry(func() error {
+			return u.untag(removed)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, updated := range oldTags.Updated(newTags).Chunks(u.batchSize) {
+		log.Printf("[DEBUG] Creating tags: %s", updated.Keys())
+		if err := u.retry(func() error {
+			return u.tag(updated)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateResourceData reconciles the tags of a resource with the change to
+// its "tags_all" attribute.
+func (u *tagsUpdater) updateResourceData(d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, n := d.GetChange("tags_all")
+	return u.updateTags(newKeyValueTags(o), newKeyValueTags(n))
+}
+
+func (u *tagsUpdater) retry(f func() error) error {
+	if u.retryable == nil {
+		return f()
+	}
+
+	return resource.Retry(tagsUpdaterRetryTimeout, func() *resource.RetryError {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if u.retryable(err) {
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
+
+// tagsRetryableAwsCodes returns a retryable function for tagsUpdater that
+// retries on any of the given error codes.
+func tagsRetryableAwsCodes(codes ...string) func(error) bool {
+	return func(err error) bool {
+		for _, code := range codes {
+			if isAWSErr(err, code, "") {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,398 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+)
+
+func TestNewKeyValueTags(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    interface{}
+		Expected keyValueTags
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: keyValueTags{},
+		},
+		{
+			Name:     "map[string]string",
+			Input:    map[string]string{"foo": "bar"},
+			Expected: keyValueTags{"foo": "bar"},
+		},
+		{
+			Name:     "map[string]*string",
+			Input:    map[string]*string{"foo": aws.String("bar"), "baz": nil},
+			Expected: keyValueTags{"foo": "bar", "baz": ""},
+		},
+		{
+			Name:     "map[string]interface{}",
+			Input:    map[string]interface{}{"foo": "bar"},
+			Expected: keyValueTags{"foo": "bar"},
+		},
+		{
+			Name:     "[]string",
+			Input:    []string{"foo", "bar"},
+			Expected: keyValueTags{"foo": "", "bar": ""},
+		},
+		{
+			Name:     "[]*string",
+			Input:    []*string{aws.String("foo")},
+			Expected: keyValueTags{"foo": ""},
+		},
+		{
+			Name:     "[]interface{}",
+			Input:    []interface{}{"foo"},
+			Expected: keyValueTags{"foo": ""},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := newKeyValueTags(tc.Input); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: bad: %#v", tc.Name, got)
+		}
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	tags := keyValueTags{
+		"aws:cloudformation:stack-name": "stack",
+		"Name":                          "foo",
+		"Patched":                       "2018-07-01",
+		"kubernetes.io/cluster/foo":     "owned",
+	}
+
+	expected := keyValueTags{
+		"Name":                      "foo",
+		"Patched":                   "2018-07-01",
+		"kubernetes.io/cluster/foo": "owned",
+	}
+	if got := tags.IgnoreAws(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("IgnoreAws: bad: %#v", got)
+	}
+
+	c := &tagIgnoreConfig{
+		Keys:        []string{"Patched"},
+		KeyPrefixes: []string{"kubernetes.io/cluster/"},
+	}
+	expected = keyValueTags{
+		"Name": "foo",
+	}
+	if got := tags.IgnoreConfig(c); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("IgnoreConfig: bad: %#v", got)
+	}
+}
+
+func TestKeyValueTagsDiff(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Old, New         keyValueTags
+		Updated, Removed keyValueTags
+	}{
+		{
+			Name:    "empty",
+			Old:     keyValueTags{},
+			New:     keyValueTags{},
+			Updated: keyValueTags{},
+			Removed: keyValueTags{},
+		},
+		{
+			Name:    "add and remove",
+			Old:     keyValueTags{"foo": "bar"},
+			New:     keyValueTags{"bar": "baz"},
+			Updated: keyValueTags{"bar": "baz"},
+			Removed: keyValueTags{"foo": "bar"},
+		},
+		{
+			Name:    "modify",
+			Old:     keyValueTags{"foo": "bar"},
+			New:     keyValueTags{"foo": "baz"},
+			Updated: keyValueTags{"foo": "baz"},
+			Removed: keyValueTags{},
+		},
+		{
+			Name:    "unchanged",
+			Old:     keyValueTags{"foo": "bar", "bar": "baz"},
+			New:     keyValueTags{"foo": "bar"},
+			Updated: keyValueTags{},
+			Removed: keyValueTags{"bar": "baz"},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := tc.Old.Updated(tc.New); !reflect.DeepEqual(got, tc.Updated) {
+			t.Fatalf("%s: bad updated: %#v", tc.Name, got)
+		}
+		if got := tc.Old.Removed(tc.New); !reflect.DeepEqual(got, tc.Removed) {
+			t.Fatalf("%s: bad removed: %#v", tc.Name, got)
+		}
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	tags := keyValueTags{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"}
+
+	cases := []struct {
+		Size     int
+		Tags     keyValueTags
+		Expected []keyValueTags
+	}{
+		{
+			Size:     2,
+			Tags:     keyValueTags{},
+			Expected: nil,
+		},
+		{
+			Size:     0,
+			Tags:     tags,
+			Expected: []keyValueTags{tags},
+		},
+		{
+			Size:     5,
+			Tags:     tags,
+			Expected: []keyValueTags{tags},
+		},
+		{
+			Size: 2,
+			Tags: tags,
+			Expected: []keyValueTags{
+				{"a": "1", "b": "2"},
+				{"c": "3", "d": "4"},
+				{"e": "5"},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		if got := tc.Tags.Chunks(tc.Size); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, got)
+		}
+	}
+}
+
+func TestTagsUpdaterUpdateTags(t *testing.T) {
+	var calls []string
+	record := func(op string) func(keyValueTags) error {
+		return func(tags keyValueTags) error {
+			calls = append(calls, op+":"+joinKeys(tags))
+			return nil
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Updater  *tagsUpdater
+		Old, New keyValueTags
+		Expected []string
+	}{
+		{
+			Name:     "no change",
+			Updater:  &tagsUpdater{tag: record("tag"), untag: record("untag")},
+			Old:      keyValueTags{"foo": "bar"},
+			New:      keyValueTags{"foo": "bar"},
+			Expected: nil,
+		},
+		{
+			Name:     "untag before tag",
+			Updater:  &tagsUpdater{tag: record("tag"), untag: record("untag")},
+			Old:      keyValueTags{"foo": "bar", "baz": "qux"},
+			New:      keyValueTags{"foo": "baz", "new": "tag"},
+			Expected: []string{"untag:baz", "tag:foo,new"},
+		},
+		{
+			Name:     "aws tags untouched",
+			Updater:  &tagsUpdater{tag: record("tag"), untag: record("untag")},
+			Old:      keyValueTags{"aws:cloudformation:stack-name": "stack"},
+			New:      keyValueTags{"foo": "bar"},
+			Expected: []string{"tag:foo"},
+		},
+		{
+			Name:     "batched",
+			Updater:  &tagsUpdater{tag: record("tag"), untag: record("untag"), batchSize: 2},
+			Old:      keyValueTags{"a": "1", "b": "2", "c": "3"},
+			New:      keyValueTags{"d": "4", "e": "5", "f": "6"},
+			Expected: []string{"untag:a,b", "untag:c", "tag:d,e", "tag:f"},
+		},
+		{
+			Name:     "replace",
+			Updater:  &tagsUpdater{replace: record("replace")},
+			Old:      keyValueTags{"foo": "bar", "baz": "qux"},
+			New:      keyValueTags{"foo": "baz"},
+			Expected: []string{"replace:foo"},
+		},
+		{
+			Name:     "replace no change",
+			Updater:  &tagsUpdater{replace: record("replace")},
+			Old:      keyValueTags{"foo": "bar"},
+			New:      keyValueTags{"foo": "bar"},
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		calls = nil
+		if err := tc.Updater.updateTags(tc.Old, tc.New); err != nil {
+			t.Fatalf("%s: err: %s", tc.Name, err)
+		}
+		if !reflect.DeepEqual(calls, tc.Expected) {
+			t.Fatalf("%s: bad: %#v", tc.Name, calls)
+		}
+	}
+}
+
+func TestTagsUpdaterRetry(t *testing.T) {
+	attempts := 0
+	u := &tagsUpdater{
+		tag: func(keyValueTags) error {
+			attempts++
+			if attempts < 3 {
+				return awserr.New("InvalidInstanceID.NotFound", "not found", nil)
+			}
+			return nil
+		},
+		retryable: tagsRetryableAwsCodes("InvalidInstanceID.NotFound"),
+	}
+
+	if err := u.updateTags(keyValueTags{}, keyValueTags{"foo": "bar"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+
+	attempts = 0
+	expected := errors.New("boom")
+	u.tag = func(keyValueTags) error {
+		attempts++
+		return expected
+	}
+
+	if err := u.updateTags(keyValueTags{}, keyValueTags{"foo": "bar"}); err != expected {
+		t.Fatalf("expected %s, got %v", expected, err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestTagsUpdaterServices(t *testing.T) {
+	sess := session.New(nil)
+
+	cases := []struct {
+		Name     string
+		Updater  func(send func(*request.Request)) *tagsUpdater
+		Expected []string
+	}{
+		{
+			Name: "EC2",
+			Updater: func(send func(*request.Request)) *tagsUpdater {
+				conn := ec2.New(sess)
+				testTagsUpdaterHandlers(&conn.Handlers, send)
+				return tagsUpdaterEC2(conn, aws.String("i-1"), aws.String("vol-1"))
+			},
+			Expected: []string{
+				`DeleteTags { Resources: ["i-1","vol-1"], Tags: [{ Key: "baz", Value: "qux" }] }`,
+				`CreateTags { Resources: ["i-1","vol-1"], Tags: [{ Key: "foo", Value: "baz" }] }`,
+			},
+		},
+		{
+			Name: "Kinesis",
+			Updater: func(send func(*request.Request)) *tagsUpdater {
+				conn := kinesis.New(sess)
+				testTagsUpdaterHandlers(&conn.Handlers, send)
+				return tagsUpdaterKinesis(conn, "stream")
+			},
+			Expected: []string{
+				`RemoveTagsFromStream { StreamName: "stream", TagKeys: ["baz"] }`,
+				`AddTagsToStream { StreamName: "stream", Tags: { foo: "baz" } }`,
+			},
+		},
+		{
+			Name: "S3",
+			Updater: func(send func(*request.Request)) *tagsUpdater {
+				conn := s3.New(sess)
+				testTagsUpdaterHandlers(&conn.Handlers, send)
+				return tagsUpdaterS3(conn, "bucket", nil, false)
+			},
+			Expected: []string{
+				`PutBucketTagging { Bucket: "bucket", Tagging: { TagSet: [{ Key: "foo", Value: "baz" }] } }`,
+			},
+		},
+		{
+			Name: "SageMaker",
+			Updater: func(send func(*request.Request)) *tagsUpdater {
+				conn := sagemaker.New(sess)
+				testTagsUpdaterHandlers(&conn.Handlers, send)
+				return tagsUpdaterSagemaker(conn, "arn:aws:sagemaker:us-west-2:123456789012:endpoint/test")
+			},
+			Expected: []string{
+				`DeleteTags { ResourceArn: "arn:aws:sagemaker:us-west-2:123456789012:endpoint/test", TagKeys: ["baz"] }`,
+				`AddTags { ResourceArn: "arn:aws:sagemaker:us-west-2:123456789012:endpoint/test", Tags: [{ Key: "foo", Value: "baz" }] }`,
+			},
+		},
+		{
+			Name: "WorkSpaces",
+			Updater: func(send func(*request.Request)) *tagsUpdater {
+				conn := workspaces.New(sess)
+				testTagsUpdaterHandlers(&conn.Handlers, send)
+				return tagsUpdaterWorkspaces(conn, "ws-12345678")
+			},
+			Expected: []string{
+				`DeleteTags { ResourceId: "ws-12345678", TagKeys: ["baz"] }`,
+				`CreateTags { ResourceId: "ws-12345678", Tags: [{ Key: "foo", Value: "baz" }] }`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		var calls []string
+		u := tc.Updater(func(r *request.Request) {
+			params := strings.Join(strings.Fields(awsutil.Prettify(r.Params)), " ")
+			calls = append(calls, fmt.Sprintf("%s %s", r.Operation.Name, params))
+		})
+
+		err := u.updateTags(
+			keyValueTags{"foo": "bar", "baz": "qux", "aws:cloudformation:stack-name": "stack"},
+			keyValueTags{"foo": "baz"},
+		)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Name, err)
+		}
+		if !reflect.DeepEqual(calls, tc.Expected) {
+			t.Fatalf("%s: bad: %#v", tc.Name, calls)
+		}
+	}
+}
+
+// testTagsUpdaterHandlers replaces the handlers of a service client so that
+// requests are passed to send instead of AWS.
+func testTagsUpdaterHandlers(h *request.Handlers, send func(*request.Request)) {
+	h.Clear()
+	h.Send.PushBack(send)
+}
+
+func joinKeys(tags keyValueTags) string {
+	var result string
+	for i, k := range tags.Keys() {
+		if i > 0 {
+			result += ","
+		}
+		result += k
+	}
+	return result
+}
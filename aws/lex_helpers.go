@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// lexVersionLatest is the version identifier of the mutable,
+	// unpublished copy of a Lex bot, intent or slot type.
+	lexVersionLatest = "$LATEST"
+
+	// lexConflictTimeout bounds how long Put and Delete calls are retried
+	// while Lex reports that a resource is being modified elsewhere.
+	lexConflictTimeout = 5 * time.Minute
+)
+
+var lexNameRegexp = regexp.MustCompile(`^([A-Za-z]_?)+$`)
+
+func validateLexName(v interface{}, k string) (ws []string, errors []error) {
+	return validateLexNameLength(v, k, 1, 100)
+}
+
+func validateLexBotName(v interface{}, k string) (ws []string, errors []error) {
+	return validateLexNameLength(v, k, 2, 50)
+}
+
+func validateLexNameLength(v interface{}, k string, min, max int) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < min || len(value) > max {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between %d and %d characters long: %q", k, min, max, value))
+	}
+	if !lexNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain only letters, optionally separated by single underscores: %q", k, value))
+	}
+	return
+}
+
+var validateLexVersion = validation.StringMatch(regexp.MustCompile(`^\$LATEST$|^[0-9]{1,64}$`), `must be "$LATEST" or a version number`)
+
+var lexMessageResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"content": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"content_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.ContentTypeCustomPayload,
+				lexmodelbuildingservice.ContentTypePlainText,
+				lexmodelbuildingservice.ContentTypeSsml,
+			}, false),
+		},
+		"group_number": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+	},
+}
+
+var lexStatementResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem:     lexMessageResource,
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50000),
+		},
+	},
+}
+
+var lexPromptResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"max_attempts": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+		"message": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem:     lexMessageResource,
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50000),
+		},
+	},
+}
+
+var lexCodeHookResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message_version": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 5),
+		},
+		"uri": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+	},
+}
+
+func expandLexMessages(rawValues []interface{}) []*lexmodelbuildingservice.Message {
+	messages := make([]*lexmodelbuildingservice.Message, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		message := &lexmodelbuildingservice.Message{
+			Content:     aws.String(value["content"].(string)),
+			ContentType: aws.String(value["content_type"].(string)),
+		}
+		if v, ok := value["group_number"].(int); ok && v != 0 {
+			message.GroupNumber = aws.Int64(int64(v))
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func flattenLexMessages(messages []*lexmodelbuildingservice.Message) []interface{} {
+	result := make([]interface{}, 0, len(messages))
+
+	for _, message := range messages {
+		result = append(result, map[string]interface{}{
+			"content":      aws.StringValue(message.Content),
+			"content_type": aws.StringValue(message.ContentType),
+			"group_number": int(aws.Int64Value(message.GroupNumber)),
+		})
+	}
+
+	return result
+}
+
+func expandLexStatement(rawValues []interface{}) *lexmodelbuildingservice.Statement {
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return nil
+	}
+	value := rawValues[0].(map[string]interface{})
+
+	statement := &lexmodelbuildingservice.Statement{
+		Messages: expandLexMessages(value["message"].(*schema.Set).List()),
+	}
+	if v, ok := value["response_card"].(string); ok && v != "" {
+		statement.ResponseCard = aws.String(v)
+	}
+
+	return statement
+}
+
+func flattenLexStatement(statement *lexmodelbuildingservice.Statement) []interface{} {
+	if statement == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"message":       flattenLexMessages(statement.Messages),
+			"response_card": aws.StringValue(statement.ResponseCard),
+		},
+	}
+}
+
+func expandLexPrompt(rawValues []interface{}) *lexmodelbuildingservice.Prompt {
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return nil
+	}
+	value := rawValues[0].(map[string]interface{})
+
+	prompt := &lexmodelbuildingservice.Prompt{
+		MaxAttempts: aws.Int64(int64(value["max_attempts"].(int))),
+		Messages:    expandLexMessages(value["message"].(*schema.Set).List()),
+	}
+	if v, ok := value["response_card"].(string); ok && v != "" {
+		prompt.ResponseCard = aws.String(v)
+	}
+
+	return prompt
+}
+
+func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_attempts":  int(aws.Int64Value(prompt.MaxAttempts)),
+			"message":       flattenLexMessages(prompt.Messages),
+			"response_card": aws.StringValue(prompt.ResponseCard),
+		},
+	}
+}
+
+func expandLexCodeHook(rawValues []interface{}) *lexmodelbuildingservice.CodeHook {
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return nil
+	}
+	value := rawValues[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(value["message_version"].(string)),
+		Uri:            aws.String(value["uri"].(string)),
+	}
+}
+
+func flattenLexCodeHook(hook *lexmodelbuildingservice.CodeHook) []interface{} {
+	if hook == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"message_version": aws.StringValue(hook.MessageVersion),
+			"uri":             aws.StringValue(hook.Uri),
+		},
+	}
+}
+
+// lexLatestVersion returns the highest numbered version in versions, or
+// $LATEST if nothing has been published yet.
+func lexLatestVersion(versions []string) string {
+	latest := 0
+	for _, v := range versions {
+		if n, err := strconv.Atoi(v); err == nil && n > latest {
+			latest = n
+		}
+	}
+
+	if latest == 0 {
+		return lexVersionLatest
+	}
+	return strconv.Itoa(latest)
+}
+
+// retryOnLexConflict retries f while Lex reports a conflicting concurrent
+// modification, e.g. a referenced intent still being updated or deleted.
+func retryOnLexConflict(f func() error) error {
+	return resource.Retry(lexConflictTimeout, func() *resource.RetryError {
+		err := f()
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func lexTimeToString(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func lexPreconditionFailedError(kind, name string, err error) error {
+	return fmt.Errorf("error updating Lex %s (%s), it was modified outside Terraform since it was last read: %s", kind, name, err)
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// pinpointChannel describes the API calls for one type of Pinpoint channel.
+//
+// Every channel type is a singleton of its application: it is enabled and
+// configured by an Update call, read by a Get call and removed by a Delete
+// call, all keyed by the application ID. resourceAwsPinpointChannel builds
+// the resource around these calls, so that the channel resources only
+// differ in their settings.
+type pinpointChannel struct {
+	// kind names the channel type in log and error messages, e.g. "SMS".
+	kind string
+
+	// update creates or updates the channel from the resource data.
+	update func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error
+
+	// get reads the channel into the resource data. A NotFoundException
+	// removes the resource from state.
+	get func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error
+
+	// remove deletes the channel.
+	remove func(conn *pinpoint.Pinpoint, applicationID string) error
+}
+
+// resourceAwsPinpointChannel returns the resource for a channel type. The
+// application_id and enabled arguments common to all channels are added to
+// the channel specific schema s.
+func resourceAwsPinpointChannel(c *pinpointChannel, s map[string]*schema.Schema) *schema.Resource {
+	s["application_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["enabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return &schema.Resource{
+		Create: c.put,
+		Read:   c.read,
+		Update: c.put,
+		Delete: c.delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func (c *pinpointChannel) put(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+	applicationID := d.Get("application_id").(string)
+
+	log.Printf("[DEBUG] Updating Pinpoint %s channel for application %s", c.kind, applicationID)
+	if err := c.update(conn, applicationID, d); err != nil {
+		return fmt.Errorf("error updating Pinpoint %s channel for application %s: %s", c.kind, applicationID, err)
+	}
+
+	d.SetId(applicationID)
+
+	return c.read(d, meta)
+}
+
+func (c *pinpointChannel) read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Reading Pinpoint %s channel for application %s", c.kind, d.Id())
+	if err := c.get(conn, d.Id(), d); err != nil {
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Pinpoint %s channel for application %s not found, removing from state", c.kind, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Pinpoint %s channel for application %s: %s", c.kind, d.Id(), err)
+	}
+
+	d.Set("application_id", d.Id())
+
+	return nil
+}
+
+func (c *pinpointChannel) delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint %s channel for application %s", c.kind, d.Id())
+	if err := c.remove(conn, d.Id()); err != nil {
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Pinpoint %s channel for application %s: %s", c.kind, d.Id(), err)
+	}
+
+	return nil
+}
+
+// pinpointApnsChannelSchema returns the settings shared by the APNs channel
+// types. The credentials are write-only, so they are never read back.
+func pinpointApnsChannelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bundle_id": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"certificate": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"default_authentication_method": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"private_key": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"team_id": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"token_key": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"token_key_id": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+// validatePinpointApnsCredentials checks that an APNs channel is configured
+// with either certificate or token credentials.
+func validatePinpointApnsCredentials(d *schema.ResourceData) error {
+	_, certificate := d.GetOk("certificate")
+	_, privateKey := d.GetOk("private_key")
+	if certificate && privateKey {
+		return nil
+	}
+
+	_, bundleID := d.GetOk("bundle_id")
+	_, teamID := d.GetOk("team_id")
+	_, tokenKey := d.GetOk("token_key")
+	_, tokenKeyID := d.GetOk("token_key_id")
+	if bundleID && teamID && tokenKey && tokenKeyID {
+		return nil
+	}
+
+	return errors.New("either certificate and private_key, or bundle_id, team_id, token_key and token_key_id are required")
+}
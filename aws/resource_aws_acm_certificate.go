@@ -176,7 +176,7 @@ func resourceAwsAcmCertificateGuessValidationMethod(domainValidationOptions []ma
 }
 
 func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		acmconn := meta.(*AWSClient).acmconn
 		err := setTagsACM(acmconn, d)
 		if err != nil {
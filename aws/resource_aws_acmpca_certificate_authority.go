@@ -448,32 +448,8 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsACMPCA(tagsFromMapACMPCA(o), tagsFromMapACMPCA(n))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing ACMPCA Certificate Authority %q tags: %#v", d.Id(), remove)
-			_, err := conn.UntagCertificateAuthority(&acmpca.UntagCertificateAuthorityInput{
-				CertificateAuthorityArn: aws.String(d.Id()),
-				Tags: remove,
-			})
-			if err != nil {
-				return fmt.Errorf("error updating ACMPCA Certificate Authority %q tags: %s", d.Id(), err)
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating ACMPCA Certificate Authority %q tags: %#v", d.Id(), create)
-			_, err := conn.TagCertificateAuthority(&acmpca.TagCertificateAuthorityInput{
-				CertificateAuthorityArn: aws.String(d.Id()),
-				Tags: create,
-			})
-			if err != nil {
-				return fmt.Errorf("error updating ACMPCA Certificate Authority %q tags: %s", d.Id(), err)
-			}
-		}
+	if err := setTagsACMPCA(conn, d); err != nil {
+		return fmt.Errorf("error updating ACMPCA Certificate Authority %q tags: %s", d.Id(), err)
 	}
 
 	return resourceAwsAcmpcaCertificateAuthorityRead(d, meta)
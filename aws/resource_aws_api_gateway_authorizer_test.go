@@ -69,6 +69,12 @@ func TestAccAWSAPIGatewayAuthorizer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_authorizer.acctest", "identity_validation_expression", ".*"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_authorizer.acctest",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayAuthorizerImportStateIdFunc("aws_api_gateway_authorizer.acctest"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -511,3 +517,14 @@ resource "aws_api_gateway_authorizer" "acctest" {
 }
 `, apiGatewayName, cognitoName, authorizerName)
 }
+
+func testAccAWSAPIGatewayAuthorizerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID), nil
+	}
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,17 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 		Read:   resourceAwsApiGatewayBasePathMappingRead,
 		Delete: resourceAwsApiGatewayBasePathMappingDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if len(idParts) != 2 || idParts[0] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected DOMAIN-NAME/BASE-PATH", d.Id())
+				}
+				d.Set("domain_name", idParts[0])
+				d.Set("base_path", idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
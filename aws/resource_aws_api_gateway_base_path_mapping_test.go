@@ -29,6 +29,11 @@ func TestAccAWSAPIGatewayBasePath_basic(t *testing.T) {
 					testAccCheckAWSAPIGatewayBasePathExists("aws_api_gateway_base_path_mapping.test", name, &conf),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_base_path_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 		Read:   resourceAwsApiGatewayDeploymentRead,
 		Update: resourceAwsApiGatewayDeploymentUpdate,
 		Delete: resourceAwsApiGatewayDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayDeploymentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -202,3 +206,78 @@ func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interfac
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayDeploymentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).apigateway
+
+	idParts := strings.Split(d.Id(), "/")
+	if (len(idParts) != 2 && len(idParts) != 3) || idParts[0] == "" || idParts[1] == "" || (len(idParts) == 3 && idParts[2] == "") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/DEPLOYMENT-ID or REST-API-ID/DEPLOYMENT-ID/STAGE-NAME", d.Id())
+	}
+	restApiId := idParts[0]
+	deploymentId := idParts[1]
+	stageName := ""
+	if len(idParts) == 3 {
+		stageName = idParts[2]
+	}
+
+	// The stage created along with the deployment is configured on this
+	// resource, so look it up to populate stage_name and its settings.
+	out, err := conn.GetStages(&apigateway.GetStagesInput{
+		RestApiId:    aws.String(restApiId),
+		DeploymentId: aws.String(deploymentId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading API Gateway Stages for Deployment (%s): %s", d.Id(), err)
+	}
+
+	stageNames := make([]string, 0, len(out.Item))
+	for _, stage := range out.Item {
+		stageNames = append(stageNames, aws.StringValue(stage.StageName))
+	}
+
+	var stage *apigateway.Stage
+	switch {
+	case stageName != "":
+		for _, s := range out.Item {
+			if aws.StringValue(s.StageName) == stageName {
+				stage = s
+			}
+		}
+		if stage == nil && len(out.Item) > 0 {
+			return nil, fmt.Errorf("error importing API Gateway Deployment (%s): stage %q not found, the deployment has stages: %s", d.Id(), stageName, strings.Join(stageNames, ", "))
+		}
+		if stage == nil {
+			// Deleting the deployment also deletes its stage, so make sure
+			// the stage does not belong to another deployment
+			s, err := conn.GetStage(&apigateway.GetStageInput{
+				RestApiId: aws.String(restApiId),
+				StageName: aws.String(stageName),
+			})
+			if err != nil && !isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+				return nil, fmt.Errorf("error reading API Gateway Stage (%s): %s", stageName, err)
+			}
+			if err == nil {
+				return nil, fmt.Errorf("error importing API Gateway Deployment (%s): stage %q belongs to deployment %s", d.Id(), stageName, aws.StringValue(s.DeploymentId))
+			}
+		}
+	case len(out.Item) == 1:
+		stage = out.Item[0]
+	case len(out.Item) == 0:
+		return nil, fmt.Errorf("error importing API Gateway Deployment (%s): the deployment has no stages, use REST-API-ID/DEPLOYMENT-ID/STAGE-NAME to set stage_name", d.Id())
+	default:
+		return nil, fmt.Errorf("error importing API Gateway Deployment (%s): the deployment has stages: %s, use REST-API-ID/DEPLOYMENT-ID/STAGE-NAME to choose one", d.Id(), strings.Join(stageNames, ", "))
+	}
+
+	d.Set("rest_api_id", restApiId)
+	if stage != nil {
+		d.Set("stage_name", stage.StageName)
+		d.Set("stage_description", stage.Description)
+		d.Set("variables", aws.StringValueMap(stage.Variables))
+	} else {
+		d.Set("stage_name", stageName)
+	}
+	d.SetId(deploymentId)
+
+	return []*schema.ResourceData{d}, nil
+}
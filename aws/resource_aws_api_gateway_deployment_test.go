@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -33,6 +34,37 @@ func TestAccAWSAPIGatewayDeployment_basic(t *testing.T) {
 						"aws_api_gateway_deployment.test", "created_date"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_deployment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayDeploymentImportStateIdFunc("aws_api_gateway_deployment.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayDeployment_importStageName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayDeploymentConfigAdditionalStage,
+			},
+			{
+				ResourceName:      "aws_api_gateway_deployment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayDeploymentImportStateIdFunc("aws_api_gateway_deployment.test"),
+				ExpectError:       regexp.MustCompile(`the deployment has stages: (test, additional|additional, test)`),
+			},
+			{
+				ResourceName:      "aws_api_gateway_deployment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayDeploymentImportStateIdWithStageFunc("aws_api_gateway_deployment.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -157,3 +189,33 @@ resource "aws_api_gateway_deployment" "test" {
   }
 }
 `
+
+func testAccAWSAPIGatewayDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSAPIGatewayDeploymentImportStateIdWithStageFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID, rs.Primary.Attributes["stage_name"]), nil
+	}
+}
+
+const testAccAWSAPIGatewayDeploymentConfigAdditionalStage = testAccAWSAPIGatewayDeploymentConfig + `
+resource "aws_api_gateway_stage" "additional" {
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+  stage_name    = "additional"
+  deployment_id = "${aws_api_gateway_deployment.test.id}"
+}
+`
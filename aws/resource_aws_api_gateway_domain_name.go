@@ -20,6 +20,9 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 		Update: resourceAwsApiGatewayDomainNameUpdate,
 		Delete: resourceAwsApiGatewayDomainNameDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 
 			//According to AWS Documentation, ACM will be the only way to add certificates
@@ -47,6 +47,11 @@ func TestAccAWSAPIGatewayDomainName_CertificateArn(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,20 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 		Read:   resourceAwsApiGatewayIntegrationResponseRead,
 		Update: resourceAwsApiGatewayIntegrationResponseCreate,
 		Delete: resourceAwsApiGatewayIntegrationResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
+				}
+				d.Set("rest_api_id", idParts[0])
+				d.Set("resource_id", idParts[1])
+				d.Set("http_method", idParts[2])
+				d.Set("status_code", idParts[3])
+				d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", idParts[0], idParts[1], idParts[2], idParts[3]))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -149,7 +164,8 @@ func resourceAwsApiGatewayIntegrationResponseRead(d *schema.ResourceData, meta i
 	log.Printf("[DEBUG] Received API Gateway Integration Response: %s", integrationResponse)
 
 	d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string), d.Get("status_code").(string)))
-	d.Set("response_templates", integrationResponse.ResponseTemplates)
+	d.Set("content_handling", integrationResponse.ContentHandling)
+	d.Set("response_templates", aws.StringValueMap(integrationResponse.ResponseTemplates))
 	d.Set("selection_pattern", integrationResponse.SelectionPattern)
 	d.Set("response_parameters", aws.StringValueMap(integrationResponse.ResponseParameters))
 	d.Set("response_parameters_in_json", aws.StringValueMap(integrationResponse.ResponseParameters))
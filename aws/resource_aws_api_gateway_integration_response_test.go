@@ -46,6 +46,13 @@ func TestAccAWSAPIGatewayIntegrationResponse_basic(t *testing.T) {
 						"aws_api_gateway_integration_response.test", "content_handling", "CONVERT_TO_BINARY"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "aws_api_gateway_integration_response.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayIntegrationResponseImportStateIdFunc("aws_api_gateway_integration_response.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"response_parameters_in_json"},
+			},
 		},
 	})
 }
@@ -290,3 +297,14 @@ resource "aws_api_gateway_integration_response" "test" {
 
 }
 `
+
+func testAccAWSAPIGatewayIntegrationResponseImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["http_method"], rs.Primary.Attributes["status_code"]), nil
+	}
+}
@@ -43,6 +43,14 @@ func TestAccAWSAPIGatewayMethod_basic(t *testing.T) {
 					testAccCheckAWSAPIGatewayMethodAttributesUpdate(&conf),
 				),
 			},
+
+			{
+				ResourceName:            "aws_api_gateway_method.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayMethodImportStateIdFunc("aws_api_gateway_method.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_parameters_in_json"},
+			},
 		},
 	})
 }
@@ -679,3 +687,14 @@ resource "aws_api_gateway_method" "test" {
 }
 `, rInt)
 }
+
+func testAccAWSAPIGatewayMethodImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["http_method"]), nil
+	}
+}
@@ -23,6 +23,9 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 		Read:   resourceAwsApiGatewayRestApiRead,
 		Update: resourceAwsApiGatewayRestApiUpdate,
 		Delete: resourceAwsApiGatewayRestApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -229,6 +232,10 @@ func resourceAwsApiGatewayRestApiRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("binary_media_types", api.BinaryMediaTypes)
 
+	if err := resourceAwsApiGatewayRestApiRefreshResources(d, meta); err != nil {
+		return fmt.Errorf("error reading API Gateway REST API (%s) root resource: %s", d.Id(), err)
+	}
+
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
 		Service:   "execute-api",
@@ -59,6 +59,12 @@ func TestAccAWSAPIGatewayUsagePlanKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_usage_plan_key.main", "value", ""),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_usage_plan_key.main",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayUsagePlanKeyImportStateIdFunc("aws_api_gateway_usage_plan_key.main"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -230,3 +236,14 @@ resource "aws_api_gateway_usage_plan_key" "main" {
 }
 `, rName, rName, rName)
 }
+
+func testAccAWSAPIGatewayUsagePlanKeyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["usage_plan_id"], rs.Primary.ID), nil
+	}
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAppsyncApiKey_basic(t *testing.T) {
+	var apiKey appsync.ApiKey
+	resourceName := "aws_appsync_api_key.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig_Required(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestMatchResourceAttr(resourceName, "key", regexp.MustCompile(`.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "expires"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncApiKey_Description(t *testing.T) {
+	var apiKey appsync.ApiKey
+	resourceName := "aws_appsync_api_key.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig_Description(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccAppsyncApiKeyConfig_Description(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncApiKey_Expires(t *testing.T) {
+	var apiKey appsync.ApiKey
+	resourceName := "aws_appsync_api_key.test"
+	rName := acctest.RandString(5)
+
+	// Keys must expire at least a day and at most a year out
+	expires1 := time.Now().UTC().Add(24 * 30 * time.Hour).Format(time.RFC3339)
+	expires2 := time.Now().UTC().Add(24 * 60 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig_Expires(rName, expires1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					testAccCheckAwsAppsyncApiKeyExpiresDate(&apiKey, expires1),
+				),
+			},
+			{
+				Config: testAccAppsyncApiKeyConfig_Expires(rName, expires2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					testAccCheckAwsAppsyncApiKeyExpiresDate(&apiKey, expires2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncApiKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_api_key" {
+			continue
+		}
+
+		apiID, keyID, err := decodeAppSyncApiKeyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		apiKey, err := getAppsyncApiKey(apiID, keyID, conn)
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if apiKey != nil {
+			return fmt.Errorf("AppSync API Key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncApiKeyExpiresDate(apiKey *appsync.ApiKey, expectedTime string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expected, err := time.Parse(time.RFC3339, expectedTime)
+		if err != nil {
+			return err
+		}
+
+		// AppSync rounds the expiration down to the hour
+		if apiKey.Expires == nil || *apiKey.Expires != expected.Truncate(time.Hour).Unix() {
+			return fmt.Errorf("expected API Key to expire at %s, got %v", expected.Truncate(time.Hour), apiKey.Expires)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsAppsyncApiKeyExists(resourceName string, apiKey *appsync.ApiKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource has no ID: %s", resourceName)
+		}
+
+		apiID, keyID, err := decodeAppSyncApiKeyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		key, err := getAppsyncApiKey(apiID, keyID, conn)
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			return fmt.Errorf("AppSync API Key %q not found", rs.Primary.ID)
+		}
+
+		*apiKey = *key
+
+		return nil
+	}
+}
+
+func testAccAppsyncApiKeyConfig_Description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  description = %q
+}
+`, rName, description)
+}
+
+func testAccAppsyncApiKeyConfig_Expires(rName, expires string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id  = "${aws_appsync_graphql_api.test.id}"
+  expires = %q
+}
+`, rName, expires)
+}
+
+func testAccAppsyncApiKeyConfig_Required(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+}
+`, rName)
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -43,6 +45,33 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 					return
 				},
 			},
+			"log_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"field_log_level": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appsync.FieldLogLevelAll,
+								appsync.FieldLogLevelError,
+								appsync.FieldLogLevelNone,
+							}, false),
+						},
+					},
+				},
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"user_pool_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -88,6 +117,10 @@ func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}
 		Name:               aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("log_config"); ok {
+		input.LogConfig = expandAppsyncGraphqlApiLogConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("user_pool_config"); ok {
 		input.UserPoolConfig = expandAppsyncGraphqlApiUserPoolConfig(v.([]interface{}))
 	}
@@ -98,8 +131,14 @@ func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	d.SetId(*resp.GraphqlApi.ApiId)
-	d.Set("arn", resp.GraphqlApi.Arn)
-	return nil
+
+	if v, ok := d.GetOk("schema"); ok {
+		if err := resourceAwsAppsyncSchemaPut(d.Id(), v.(string), conn); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppsyncGraphqlApiRead(d, meta)
 }
 
 func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{}) error {
@@ -121,6 +160,11 @@ func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("authentication_type", resp.GraphqlApi.AuthenticationType)
 	d.Set("name", resp.GraphqlApi.Name)
+
+	if err := d.Set("log_config", flattenAppsyncGraphqlApiLogConfig(resp.GraphqlApi.LogConfig)); err != nil {
+		return fmt.Errorf("error setting log_config: %s", err)
+	}
+
 	d.Set("user_pool_config", flattenAppsyncGraphqlApiUserPoolConfig(resp.GraphqlApi.UserPoolConfig))
 	d.Set("arn", resp.GraphqlApi.Arn)
 	return nil
@@ -137,6 +181,9 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("authentication_type") {
 		input.AuthenticationType = aws.String(d.Get("authentication_type").(string))
 	}
+	if d.HasChange("log_config") {
+		input.LogConfig = expandAppsyncGraphqlApiLogConfig(d.Get("log_config").([]interface{}))
+	}
 	if d.HasChange("user_pool_config") {
 		input.UserPoolConfig = expandAppsyncGraphqlApiUserPoolConfig(d.Get("user_pool_config").([]interface{}))
 	}
@@ -146,6 +193,14 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	if d.HasChange("schema") {
+		if v, ok := d.GetOk("schema"); ok {
+			if err := resourceAwsAppsyncSchemaPut(d.Id(), v.(string), conn); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAwsAppsyncGraphqlApiRead(d, meta)
 }
 
@@ -166,6 +221,65 @@ func resourceAwsAppsyncGraphqlApiDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func expandAppsyncGraphqlApiLogConfig(config []interface{}) *appsync.LogConfig {
+	if len(config) < 1 || config[0] == nil {
+		return nil
+	}
+	cg := config[0].(map[string]interface{})
+	return &appsync.LogConfig{
+		CloudWatchLogsRoleArn: aws.String(cg["cloudwatch_logs_role_arn"].(string)),
+		FieldLogLevel:         aws.String(cg["field_log_level"].(string)),
+	}
+}
+
+func flattenAppsyncGraphqlApiLogConfig(lc *appsync.LogConfig) []interface{} {
+	if lc == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"cloudwatch_logs_role_arn": aws.StringValue(lc.CloudWatchLogsRoleArn),
+		"field_log_level":          aws.StringValue(lc.FieldLogLevel),
+	}
+
+	return []interface{}{m}
+}
+
+// resourceAwsAppsyncSchemaPut uploads the schema definition and waits for
+// AppSync to finish processing it.
+func resourceAwsAppsyncSchemaPut(apiId, definition string, conn *appsync.AppSync) error {
+	input := &appsync.StartSchemaCreationInput{
+		ApiId:      aws.String(apiId),
+		Definition: []byte(definition),
+	}
+	if _, err := conn.StartSchemaCreation(input); err != nil {
+		return fmt.Errorf("error creating AppSync GraphQL API (%s) schema: %s", apiId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SchemaStatusProcessing},
+		Target:  []string{"SUCCESS", appsync.SchemaStatusActive}, // should be only SUCCESS, but some regions report ACTIVE
+		Refresh: func() (interface{}, string, error) {
+			result, err := conn.GetSchemaCreationStatus(&appsync.GetSchemaCreationStatusInput{
+				ApiId: aws.String(apiId),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if aws.StringValue(result.Status) == "FAILED" {
+				return result, "FAILED", fmt.Errorf("%s", aws.StringValue(result.Details))
+			}
+			return result, aws.StringValue(result.Status), nil
+		},
+		Timeout:    2 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for AppSync GraphQL API (%s) schema creation: %s", apiId, err)
+	}
+
+	return nil
+}
+
 func expandAppsyncGraphqlApiUserPoolConfig(config []interface{}) *appsync.UserPoolConfig {
 	if len(config) < 1 {
 		return nil
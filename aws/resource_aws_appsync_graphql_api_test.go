@@ -62,6 +62,67 @@ func TestAccAWSAppsyncGraphqlApi_cognito(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppsyncGraphqlApi_schema(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test_schema"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_schema(rName, "Post"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					testAccCheckAwsAppsyncGraphqlApiTypeExists(resourceName, "Post"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_schema(rName, "PostV2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					testAccCheckAwsAppsyncGraphqlApiTypeExists(resourceName, "PostV2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_logConfig(t *testing.T) {
+	resourceName := "aws_appsync_graphql_api.test_log"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_logConfig(rName, "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_config.0.cloudwatch_logs_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "log_config.0.field_log_level", "ALL"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_logConfig(rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_config.0.field_log_level", "ERROR"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSAppsyncGraphqlApi_import(t *testing.T) {
 	resourceName := "aws_appsync_graphql_api.test_apikey"
 
@@ -126,6 +187,26 @@ func testAccCheckAwsAppsyncGraphqlApiExists(name string) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckAwsAppsyncGraphqlApiTypeExists(name, typeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		input := &appsync.GetTypeInput{
+			ApiId:    aws.String(rs.Primary.ID),
+			TypeName: aws.String(typeName),
+			Format:   aws.String(appsync.OutputTypeSdl),
+		}
+
+		_, err := conn.GetType(input)
+		return err
+	}
+}
+
 func testAccAppsyncGraphqlApiConfig_apikey(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_appsync_graphql_api" "test_apikey" {
@@ -165,3 +246,65 @@ resource "aws_appsync_graphql_api" "test_cognito" {
 }
 `, rName, rName)
 }
+
+func testAccAppsyncGraphqlApiConfig_schema(rName, typeName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test_schema" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+
+  schema = <<EOF
+schema {
+  query: Query
+}
+
+type Query {
+  test: %s
+}
+
+type %s {
+  id: ID!
+  title: String
+}
+EOF
+}
+`, rName, typeName, typeName)
+}
+
+func testAccAppsyncGraphqlApiConfig_logConfig(rName, fieldLogLevel string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-appsync-%[1]s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSAppSyncPushToCloudWatchLogs"
+  role       = "${aws_iam_role.test.name}"
+}
+
+resource "aws_appsync_graphql_api" "test_log" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%[1]s"
+
+  log_config {
+    cloudwatch_logs_role_arn = "${aws_iam_role.test.arn}"
+    field_log_level          = %[2]q
+  }
+}
+`, rName, fieldLogLevel)
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsAppsyncResolver_basic(t *testing.T) {
+	resourceName := "aws_appsync_resolver.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig(rName, "singlePost"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Query"),
+					resource.TestCheckResourceAttr(resourceName, "field", "singlePost"),
+					resource.TestCheckResourceAttr(resourceName, "data_source", fmt.Sprintf("tf_appsync_%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAppsyncResolver_requestTemplate(t *testing.T) {
+	resourceName := "aws_appsync_resolver.test"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig_requestTemplate(rName, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "request_template", regexp.MustCompile(`"resourcePath": "/"`)),
+				),
+			},
+			{
+				Config: testAccAppsyncResolverConfig_requestTemplate(rName, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "request_template", regexp.MustCompile(`"resourcePath": "/test"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncResolverDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_resolver" {
+			continue
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		input := &appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			TypeName:  aws.String(typeName),
+			FieldName: aws.String(fieldName),
+		}
+
+		_, err = conn.GetResolver(input)
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("AppSync Resolver %q still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckAwsAppsyncResolverExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource has no ID: %s", name)
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		input := &appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			TypeName:  aws.String(typeName),
+			FieldName: aws.String(fieldName),
+		}
+
+		_, err = conn.GetResolver(input)
+
+		return err
+	}
+}
+
+func testAccAppsyncResolverConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%[1]s"
+
+  schema = <<EOF
+type Mutation {
+	putPost(id: ID!, title: String!): Post
+}
+
+type Post {
+	id: ID!
+	title: String!
+}
+
+type Query {
+	singlePost(id: ID!): Post
+}
+
+schema {
+	query: Query
+	mutation: Mutation
+}
+EOF
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  name   = "tf_appsync_%[1]s"
+  type   = "NONE"
+}
+`, rName)
+}
+
+func testAccAppsyncResolverConfig(rName, field string) string {
+	return testAccAppsyncResolverConfigBase(rName) + fmt.Sprintf(`
+resource "aws_appsync_resolver" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  field       = %q
+  type        = "Query"
+  data_source = "${aws_appsync_datasource.test.name}"
+
+  request_template = <<EOF
+{
+    "version": "2017-02-28",
+    "payload": $util.toJson($context.arguments)
+}
+EOF
+
+  response_template = "$util.toJson($context.result)"
+}
+`, field)
+}
+
+func testAccAppsyncResolverConfig_requestTemplate(rName, resourcePath string) string {
+	return testAccAppsyncResolverConfigBase(rName) + fmt.Sprintf(`
+resource "aws_appsync_resolver" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  field       = "singlePost"
+  type        = "Query"
+  data_source = "${aws_appsync_datasource.test.name}"
+
+  request_template = <<EOF
+{
+    "version": "2017-02-28",
+    "payload": {
+        "resourcePath": "%s"
+    }
+}
+EOF
+
+  response_template = "$util.toJson($context.result)"
+}
+`, resourcePath)
+}
@@ -190,6 +190,52 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"rolling_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_batch_size": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ConflictsWith: []string{"rolling_update.0.max_batch_percentage"},
+							ValidateFunc:  validation.IntAtLeast(1),
+						},
+						"max_batch_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ConflictsWith: []string{"rolling_update.0.max_batch_size"},
+							ValidateFunc:  validation.IntBetween(1, 100),
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"pause_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "0s",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(string)
+								duration, err := time.ParseDuration(value)
+								if err != nil {
+									errors = append(errors, fmt.Errorf(
+										"%q cannot be parsed as a duration: %s", k, err))
+								}
+								if duration < 0 {
+									errors = append(errors, fmt.Errorf(
+										"%q must be greater than zero", k))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+
 			"enabled_metrics": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -290,6 +336,7 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			resourceAwsAutoscalingGroupCustomizeDiffRollingUpdate,
 		),
 	}
 }
@@ -586,7 +633,13 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		ignoreTags := meta.(*AWSClient).ignoreTags
+		for _, t := range g.Tags {
+			if !ignoreTags.ignored(aws.StringValue(t.Key)) {
+				tagList = append(tagList, t)
+			}
+		}
+		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList))
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -623,6 +676,13 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).autoscalingconn
 	shouldWaitForCapacity := false
 
+	shouldRoll := shouldRollAutoscalingGroup(d)
+	if shouldRoll {
+		if err := validateAutoscalingGroupRollingUpdateTimeout(d.Get("wait_for_capacity_timeout").(string)); err != nil {
+			return err
+		}
+	}
+
 	opts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(d.Id()),
 	}
@@ -794,6 +854,18 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if shouldRoll {
+		if err := rollAutoscalingGroupInstances(d, meta); err != nil {
+			// Keep the previous launch configuration in state so the next
+			// apply resumes the rolling update
+			d.Partial(true)
+			if rErr := rollbackAutoscalingGroupUpdate(d, meta); rErr != nil {
+				return fmt.Errorf("Error performing rolling update of AutoScaling Group: %s; additionally, %s", err, rErr)
+			}
+			return errwrap.Wrapf("Error performing rolling update of AutoScaling Group, rolled back to the previous launch configuration: {{err}}", err)
+		}
+	}
+
 	if d.HasChange("enabled_metrics") {
 		if err := updateASGMetricsCollection(d, conn); err != nil {
 			return errwrap.Wrapf("Error updating AutoScaling Group Metrics collection: {{err}}", err)
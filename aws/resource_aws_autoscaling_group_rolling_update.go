@@ -0,0 +1,394 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// shouldRollAutoscalingGroup reports whether the update must replace the
+// instances of the ASG, which is the case when a rolling_update block is
+// configured and the launch configuration or template changed.
+func shouldRollAutoscalingGroup(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("rolling_update"); !ok || len(v.([]interface{})) == 0 {
+		return false
+	}
+
+	return d.HasChange("launch_configuration") || d.HasChange("launch_template")
+}
+
+// resourceAwsAutoscalingGroupCustomizeDiffRollingUpdate rejects a
+// rolling_update block without capacity waiting at plan time, rather than
+// when the launch configuration or template changes.
+func resourceAwsAutoscalingGroupCustomizeDiffRollingUpdate(diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("rolling_update"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+	if !diff.NewValueKnown("wait_for_capacity_timeout") {
+		return nil
+	}
+
+	return validateAutoscalingGroupRollingUpdateTimeout(diff.Get("wait_for_capacity_timeout").(string))
+}
+
+// validateAutoscalingGroupRollingUpdateTimeout checks that capacity waiting,
+// which checks the health of the replacement instances between batches, is
+// not disabled.
+func validateAutoscalingGroupRollingUpdateTimeout(timeout string) error {
+	wait, err := time.ParseDuration(timeout)
+	if err != nil {
+		return fmt.Errorf("wait_for_capacity_timeout cannot be parsed as a duration: %s", err)
+	}
+	if wait == 0 {
+		return fmt.Errorf("rolling_update requires a non-zero wait_for_capacity_timeout to check the health of replacement instances")
+	}
+
+	return nil
+}
+
+// rollAutoscalingGroupInstances replaces, batch by batch, every instance of
+// the ASG not launched from its current launch configuration or template.
+// Each batch is terminated without decrementing the desired capacity, so the
+// ASG launches up-to-date replacements, and waitForASGCapacity then waits
+// until the replacements are healthy in the ASG and its attached ELBs and
+// target groups. If replacing a batch would take the ASG below the minimum
+// number of healthy instances, the desired capacity (and, if needed, the
+// maximum size) is temporarily raised for the duration of the update.
+func rollAutoscalingGroupInstances(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+	cfg := d.Get("rolling_update").([]interface{})[0].(map[string]interface{})
+
+	pause, err := time.ParseDuration(cfg["pause_time"].(string))
+	if err != nil {
+		return err
+	}
+
+	wait, err := time.ParseDuration(d.Get("wait_for_capacity_timeout").(string))
+	if err != nil {
+		return err
+	}
+
+	g, err := getAwsAutoscalingGroup(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return fmt.Errorf("AutoScaling Group (%s) not found", d.Id())
+	}
+
+	desired := int(aws.Int64Value(g.DesiredCapacity))
+	maxSize := int(aws.Int64Value(g.MaxSize))
+	if desired == 0 {
+		log.Printf("[DEBUG] AutoScaling Group (%s) has no desired capacity, skipping rolling update", d.Id())
+		return nil
+	}
+
+	batchSize := autoscalingGroupRollingUpdateBatchSize(cfg, desired)
+	minHealthy := autoscalingGroupRollingUpdateMinHealthy(cfg, desired)
+
+	surge := minHealthy - (desired - batchSize)
+	if surge < 0 {
+		surge = 0
+	}
+	target := desired + surge
+
+	if surge > 0 {
+		log.Printf("[DEBUG] Raising AutoScaling Group (%s) desired capacity to %d for rolling update", d.Id(), target)
+		opts := &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(d.Id()),
+			DesiredCapacity:      aws.Int64(int64(target)),
+		}
+		if target > maxSize {
+			opts.MaxSize = aws.Int64(int64(target))
+		}
+		if _, err := conn.UpdateAutoScalingGroup(opts); err != nil {
+			return fmt.Errorf("Error raising AutoScaling Group capacity for rolling update: %s", err)
+		}
+	}
+
+	err = replaceAutoscalingGroupOutdatedInstances(d, meta, batchSize, target, pause, wait)
+
+	if surge > 0 {
+		log.Printf("[DEBUG] Restoring AutoScaling Group (%s) desired capacity to %d after rolling update", d.Id(), desired)
+		opts := &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(d.Id()),
+			DesiredCapacity:      aws.Int64(int64(desired)),
+			MaxSize:              aws.Int64(int64(maxSize)),
+		}
+		if _, rErr := conn.UpdateAutoScalingGroup(opts); rErr != nil {
+			if err != nil {
+				return fmt.Errorf("%s; additionally, error restoring AutoScaling Group capacity: %s", err, rErr)
+			}
+			return fmt.Errorf("Error restoring AutoScaling Group capacity after rolling update: %s", rErr)
+		}
+	}
+
+	return err
+}
+
+func replaceAutoscalingGroupOutdatedInstances(d *schema.ResourceData, meta interface{}, batchSize, target int, pause, wait time.Duration) error {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	g, err := getAwsAutoscalingGroup(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return fmt.Errorf("AutoScaling Group (%s) not found", d.Id())
+	}
+
+	lt, err := getAutoscalingGroupLaunchTemplate(meta.(*AWSClient).ec2conn, g)
+	if err != nil {
+		return err
+	}
+
+	// Replacing more instances than were outdated to begin with means the
+	// replacements are not considered up to date either, so give up rather
+	// than replace instances forever
+	remaining := len(autoscalingGroupOutdatedInstanceIds(g, lt))
+
+	for batch := 1; ; batch++ {
+		if batch > 1 {
+			g, err = getAwsAutoscalingGroup(d.Id(), conn)
+			if err != nil {
+				return err
+			}
+			if g == nil {
+				return fmt.Errorf("AutoScaling Group (%s) not found", d.Id())
+			}
+		}
+
+		outdated := autoscalingGroupOutdatedInstanceIds(g, lt)
+		if len(outdated) == 0 {
+			log.Printf("[DEBUG] AutoScaling Group (%s) has no outdated instances left", d.Id())
+			return nil
+		}
+		if remaining <= 0 {
+			return fmt.Errorf("AutoScaling Group (%s) still has %d outdated instances after replacing all instances outdated at the start of the rolling update", d.Id(), len(outdated))
+		}
+		if len(outdated) > batchSize {
+			outdated = outdated[:batchSize]
+		}
+		if len(outdated) > remaining {
+			outdated = outdated[:remaining]
+		}
+		remaining -= len(outdated)
+
+		if batch > 1 && pause > 0 {
+			log.Printf("[DEBUG] Pausing %s before batch %d of AutoScaling Group (%s) rolling update", pause, batch, d.Id())
+			time.Sleep(pause)
+		}
+
+		log.Printf("[DEBUG] Replacing batch %d of AutoScaling Group (%s) instances: %v", batch, d.Id(), outdated)
+		for _, id := range outdated {
+			_, err := conn.TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+				InstanceId:                     aws.String(id),
+				ShouldDecrementDesiredCapacity: aws.Bool(false),
+			})
+			if err != nil {
+				return fmt.Errorf("Error terminating AutoScaling Group instance %s: %s", id, err)
+			}
+		}
+
+		// Wait for the batch to leave service first, or it would still count
+		// towards the capacity we wait for below
+		err = resource.Retry(wait, func() *resource.RetryError {
+			g, err := getAwsAutoscalingGroup(d.Id(), conn)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if g == nil {
+				return resource.NonRetryableError(fmt.Errorf("AutoScaling Group (%s) not found", d.Id()))
+			}
+
+			for _, i := range g.Instances {
+				for _, id := range outdated {
+					if aws.StringValue(i.InstanceId) == id && strings.EqualFold(aws.StringValue(i.LifecycleState), autoscaling.LifecycleStateInService) {
+						return resource.RetryableError(fmt.Errorf("instance %s is still in service", id))
+					}
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error waiting for AutoScaling Group instances to leave service: %s", err)
+		}
+
+		err = waitForASGCapacity(d, meta, func(d *schema.ResourceData, haveASG, haveELB int) (bool, string) {
+			return capacitySatisfiedRollingUpdate(target, haveASG, haveELB)
+		})
+		if err != nil {
+			return fmt.Errorf("Error waiting for replacement instances of batch %d to become healthy: %s", batch, err)
+		}
+	}
+}
+
+// rollbackAutoscalingGroupUpdate undoes a failed rolling update: the ASG is
+// pointed back at its previous launch configuration or template, and the
+// instances already replaced are in turn replaced, with the same batches and
+// health checks, by instances launched from it.
+func rollbackAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	if err := rollbackAutoscalingGroupLaunchConfiguration(d, conn); err != nil {
+		return fmt.Errorf("error rolling back launch configuration: %s", err)
+	}
+
+	if err := rollAutoscalingGroupInstances(d, meta); err != nil {
+		return fmt.Errorf("error rolling back instances, the next apply resumes the rolling update: %s", err)
+	}
+
+	return nil
+}
+
+// rollbackAutoscalingGroupLaunchConfiguration points the ASG back at the
+// launch configuration or template it used before the update, so that
+// instances launched from now on match the configuration kept in state.
+func rollbackAutoscalingGroupLaunchConfiguration(d *schema.ResourceData, conn *autoscaling.AutoScaling) error {
+	opts := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(d.Id()),
+	}
+
+	if o, _ := d.GetChange("launch_configuration"); o.(string) != "" {
+		opts.LaunchConfigurationName = aws.String(o.(string))
+	} else if o, _ := d.GetChange("launch_template"); len(o.([]interface{})) > 0 {
+		lt, err := expandLaunchTemplateSpecification(o.([]interface{}))
+		if err != nil {
+			return err
+		}
+		opts.LaunchTemplate = lt
+	} else {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Rolling back AutoScaling Group (%s) launch configuration: %s", d.Id(), opts)
+	_, err := conn.UpdateAutoScalingGroup(opts)
+	return err
+}
+
+// getAutoscalingGroupLaunchTemplate returns the launch template of the ASG,
+// used to resolve the $Latest and $Default versions, or nil if the ASG uses
+// a launch configuration.
+func getAutoscalingGroupLaunchTemplate(conn *ec2.EC2, g *autoscaling.Group) (*ec2.LaunchTemplate, error) {
+	if g.LaunchTemplate == nil {
+		return nil, nil
+	}
+
+	input := &ec2.DescribeLaunchTemplatesInput{}
+	if g.LaunchTemplate.LaunchTemplateId != nil {
+		input.LaunchTemplateIds = []*string{g.LaunchTemplate.LaunchTemplateId}
+	} else {
+		input.LaunchTemplateNames = []*string{g.LaunchTemplate.LaunchTemplateName}
+	}
+
+	resp, err := conn.DescribeLaunchTemplates(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error describing launch template of AutoScaling Group (%s): %s", aws.StringValue(g.AutoScalingGroupName), err)
+	}
+	if len(resp.LaunchTemplates) == 0 {
+		return nil, fmt.Errorf("Launch template of AutoScaling Group (%s) not found", aws.StringValue(g.AutoScalingGroupName))
+	}
+
+	return resp.LaunchTemplates[0], nil
+}
+
+// autoscalingGroupLaunchTemplateVersion returns the ID and version number of
+// a launch template specification, resolving $Latest and $Default when the
+// specification refers to the given launch template.
+func autoscalingGroupLaunchTemplateVersion(spec *autoscaling.LaunchTemplateSpecification, lt *ec2.LaunchTemplate) (string, string) {
+	id := aws.StringValue(spec.LaunchTemplateId)
+	version := aws.StringValue(spec.Version)
+
+	if lt == nil {
+		return id, version
+	}
+	if id != aws.StringValue(lt.LaunchTemplateId) && aws.StringValue(spec.LaunchTemplateName) != aws.StringValue(lt.LaunchTemplateName) {
+		return id, version
+	}
+
+	id = aws.StringValue(lt.LaunchTemplateId)
+	switch version {
+	case "", "$Default":
+		version = strconv.FormatInt(aws.Int64Value(lt.DefaultVersionNumber), 10)
+	case "$Latest":
+		version = strconv.FormatInt(aws.Int64Value(lt.LatestVersionNumber), 10)
+	}
+
+	return id, version
+}
+
+// autoscalingGroupOutdatedInstanceIds returns the IDs of the instances that
+// were not launched from the current launch configuration or template of the
+// ASG. Instances already on their way out are skipped.
+func autoscalingGroupOutdatedInstanceIds(g *autoscaling.Group, lt *ec2.LaunchTemplate) []string {
+	var ids []string
+
+	for _, i := range g.Instances {
+		if strings.HasPrefix(aws.StringValue(i.LifecycleState), autoscaling.LifecycleStateTerminating) || aws.StringValue(i.LifecycleState) == autoscaling.LifecycleStateTerminated {
+			continue
+		}
+
+		if g.LaunchTemplate != nil {
+			if i.LaunchTemplate != nil {
+				groupId, groupVersion := autoscalingGroupLaunchTemplateVersion(g.LaunchTemplate, lt)
+				instanceId, instanceVersion := autoscalingGroupLaunchTemplateVersion(i.LaunchTemplate, lt)
+				if instanceId == groupId && instanceVersion == groupVersion {
+					continue
+				}
+			}
+		} else if aws.StringValue(i.LaunchConfigurationName) == aws.StringValue(g.LaunchConfigurationName) {
+			continue
+		}
+
+		ids = append(ids, aws.StringValue(i.InstanceId))
+	}
+
+	return ids
+}
+
+// autoscalingGroupRollingUpdateBatchSize returns the number of instances to
+// replace at once, which is at least one.
+func autoscalingGroupRollingUpdateBatchSize(cfg map[string]interface{}, desired int) int {
+	size := 1
+
+	if v, ok := cfg["max_batch_size"].(int); ok && v > 0 {
+		size = v
+	} else if v, ok := cfg["max_batch_percentage"].(int); ok && v > 0 {
+		size = (desired*v + 99) / 100
+	}
+
+	if size < 1 {
+		size = 1
+	}
+
+	return size
+}
+
+// autoscalingGroupRollingUpdateMinHealthy returns the number of healthy
+// instances to keep in service, rounded up.
+func autoscalingGroupRollingUpdateMinHealthy(cfg map[string]interface{}, desired int) int {
+	return (desired*cfg["min_healthy_percentage"].(int) + 99) / 100
+}
+
+// capacitySatisfiedRollingUpdate requires the target number of healthy
+// instances, both in the ASG and in its ELBs and target groups.
+func capacitySatisfiedRollingUpdate(target, haveASG, haveELB int) (bool, string) {
+	if haveASG < target {
+		return false, fmt.Sprintf(
+			"Need at least %d healthy instances in ASG, have %d", target, haveASG)
+	}
+	if haveELB < target {
+		return false, fmt.Sprintf(
+			"Need at least %d healthy instances in ELB, have %d", target, haveELB)
+	}
+	return true, ""
+}
@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAutoscalingGroupRollingUpdateBatchSize(t *testing.T) {
+	cases := map[string]struct {
+		Config  map[string]interface{}
+		Desired int
+		Expect  int
+	}{
+		"default": {
+			Config:  map[string]interface{}{"max_batch_size": 0, "max_batch_percentage": 0},
+			Desired: 10,
+			Expect:  1,
+		},
+		"size": {
+			Config:  map[string]interface{}{"max_batch_size": 3, "max_batch_percentage": 0},
+			Desired: 10,
+			Expect:  3,
+		},
+		"percentage": {
+			Config:  map[string]interface{}{"max_batch_size": 0, "max_batch_percentage": 25},
+			Desired: 10,
+			Expect:  3,
+		},
+		"percentage of small group": {
+			Config:  map[string]interface{}{"max_batch_size": 0, "max_batch_percentage": 10},
+			Desired: 2,
+			Expect:  1,
+		},
+	}
+
+	for name, tc := range cases {
+		if got := autoscalingGroupRollingUpdateBatchSize(tc.Config, tc.Desired); got != tc.Expect {
+			t.Errorf("%s: expected batch size %d, got %d", name, tc.Expect, got)
+		}
+	}
+}
+
+func TestAutoscalingGroupRollingUpdateMinHealthy(t *testing.T) {
+	cases := map[string]struct {
+		Percentage int
+		Desired    int
+		Expect     int
+	}{
+		"all":        {Percentage: 100, Desired: 4, Expect: 4},
+		"none":       {Percentage: 0, Desired: 4, Expect: 0},
+		"rounded up": {Percentage: 50, Desired: 3, Expect: 2},
+	}
+
+	for name, tc := range cases {
+		cfg := map[string]interface{}{"min_healthy_percentage": tc.Percentage}
+		if got := autoscalingGroupRollingUpdateMinHealthy(cfg, tc.Desired); got != tc.Expect {
+			t.Errorf("%s: expected %d healthy instances, got %d", name, tc.Expect, got)
+		}
+	}
+}
+
+func TestAutoscalingGroupOutdatedInstanceIds(t *testing.T) {
+	cases := map[string]struct {
+		Group          *autoscaling.Group
+		LaunchTemplate *ec2.LaunchTemplate
+		Expect         []string
+	}{
+		"launch configuration": {
+			Group: &autoscaling.Group{
+				LaunchConfigurationName: aws.String("new"),
+				Instances: []*autoscaling.Instance{
+					{InstanceId: aws.String("i-1"), LaunchConfigurationName: aws.String("old"), LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-2"), LaunchConfigurationName: aws.String("new"), LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-3"), LaunchConfigurationName: aws.String("old"), LifecycleState: aws.String("Terminating:Wait")},
+					{InstanceId: aws.String("i-4"), LaunchConfigurationName: aws.String("old"), LifecycleState: aws.String("Pending")},
+				},
+			},
+			Expect: []string{"i-1", "i-4"},
+		},
+		"launch template": {
+			Group: &autoscaling.Group{
+				LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+					LaunchTemplateId: aws.String("lt-1"),
+					Version:          aws.String("2"),
+				},
+				Instances: []*autoscaling.Instance{
+					{InstanceId: aws.String("i-1"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("1")}, LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-2"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("2")}, LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-3"), LaunchConfigurationName: aws.String("old"), LifecycleState: aws.String("InService")},
+				},
+			},
+			Expect: []string{"i-1", "i-3"},
+		},
+		"launch template $Latest": {
+			Group: &autoscaling.Group{
+				LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+					LaunchTemplateId:   aws.String("lt-1"),
+					LaunchTemplateName: aws.String("example"),
+					Version:            aws.String("$Latest"),
+				},
+				Instances: []*autoscaling.Instance{
+					{InstanceId: aws.String("i-1"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("2")}, LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-2"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("3")}, LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-3"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateName: aws.String("example"), Version: aws.String("$Latest")}, LifecycleState: aws.String("InService")},
+				},
+			},
+			LaunchTemplate: &ec2.LaunchTemplate{
+				LaunchTemplateId:     aws.String("lt-1"),
+				LaunchTemplateName:   aws.String("example"),
+				DefaultVersionNumber: aws.Int64(1),
+				LatestVersionNumber:  aws.Int64(3),
+			},
+			Expect: []string{"i-1"},
+		},
+		"launch template $Default": {
+			Group: &autoscaling.Group{
+				LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+					LaunchTemplateId: aws.String("lt-1"),
+					Version:          aws.String("$Default"),
+				},
+				Instances: []*autoscaling.Instance{
+					{InstanceId: aws.String("i-1"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("1")}, LifecycleState: aws.String("InService")},
+					{InstanceId: aws.String("i-2"), LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1"), Version: aws.String("3")}, LifecycleState: aws.String("InService")},
+				},
+			},
+			LaunchTemplate: &ec2.LaunchTemplate{
+				LaunchTemplateId:     aws.String("lt-1"),
+				LaunchTemplateName:   aws.String("example"),
+				DefaultVersionNumber: aws.Int64(1),
+				LatestVersionNumber:  aws.Int64(3),
+			},
+			Expect: []string{"i-2"},
+		},
+		"up to date": {
+			Group: &autoscaling.Group{
+				LaunchConfigurationName: aws.String("new"),
+				Instances: []*autoscaling.Instance{
+					{InstanceId: aws.String("i-1"), LaunchConfigurationName: aws.String("new"), LifecycleState: aws.String("InService")},
+				},
+			},
+			Expect: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		if got := autoscalingGroupOutdatedInstanceIds(tc.Group, tc.LaunchTemplate); !reflect.DeepEqual(got, tc.Expect) {
+			t.Errorf("%s: expected outdated instances %v, got %v", name, tc.Expect, got)
+		}
+	}
+}
+
+func TestCapacitySatisfiedRollingUpdate(t *testing.T) {
+	cases := map[string]struct {
+		Target          int
+		HaveASG         int
+		HaveELB         int
+		ExpectSatisfied bool
+		ExpectReason    string
+	}{
+		"satisfied": {
+			Target:          3,
+			HaveASG:         3,
+			HaveELB:         3,
+			ExpectSatisfied: true,
+		},
+		"not enough in ASG": {
+			Target:          3,
+			HaveASG:         2,
+			HaveELB:         2,
+			ExpectSatisfied: false,
+			ExpectReason:    "Need at least 3 healthy instances in ASG, have 2",
+		},
+		"not enough in ELB": {
+			Target:          3,
+			HaveASG:         3,
+			HaveELB:         2,
+			ExpectSatisfied: false,
+			ExpectReason:    "Need at least 3 healthy instances in ELB, have 2",
+		},
+	}
+
+	for name, tc := range cases {
+		gotSatisfied, gotReason := capacitySatisfiedRollingUpdate(tc.Target, tc.HaveASG, tc.HaveELB)
+
+		if gotSatisfied != tc.ExpectSatisfied {
+			t.Fatalf("%s: expected satisfied: %t, got: %t (reason: %s)",
+				name, tc.ExpectSatisfied, gotSatisfied, gotReason)
+		}
+
+		if gotReason != tc.ExpectReason {
+			t.Fatalf("%s: expected reason: %s, got: %s",
+				name, tc.ExpectReason, gotReason)
+		}
+	}
+}
+
+func TestValidateAutoscalingGroupRollingUpdateTimeout(t *testing.T) {
+	cases := map[string]bool{
+		"10m":  false,
+		"30s":  false,
+		"0":    true,
+		"0s":   true,
+		"0m":   true,
+		"0h0m": true,
+	}
+
+	for timeout, expectError := range cases {
+		err := validateAutoscalingGroupRollingUpdateTimeout(timeout)
+		if expectError && err == nil {
+			t.Errorf("%q: expected error, got none", timeout)
+		}
+		if !expectError && err != nil {
+			t.Errorf("%q: unexpected error: %s", timeout, err)
+		}
+	}
+}
+
+func TestRollbackAutoscalingGroupUpdate(t *testing.T) {
+	// The update to launch configuration "new" failed after replacing i-2
+	g := &autoscaling.Group{
+		AutoScalingGroupName:    aws.String("test"),
+		DesiredCapacity:         aws.Int64(2),
+		MaxSize:                 aws.Int64(2),
+		LaunchConfigurationName: aws.String("new"),
+		Instances: []*autoscaling.Instance{
+			{InstanceId: aws.String("i-1"), LaunchConfigurationName: aws.String("old"), LifecycleState: aws.String("InService"), HealthStatus: aws.String("Healthy")},
+			{InstanceId: aws.String("i-2"), LaunchConfigurationName: aws.String("new"), LifecycleState: aws.String("InService"), HealthStatus: aws.String("Healthy")},
+		},
+	}
+
+	var calls []string
+	conn := autoscaling.New(session.New(nil))
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch input := r.Params.(type) {
+		case *autoscaling.DescribeAutoScalingGroupsInput:
+			r.Data.(*autoscaling.DescribeAutoScalingGroupsOutput).AutoScalingGroups = []*autoscaling.Group{g}
+		case *autoscaling.UpdateAutoScalingGroupInput:
+			calls = append(calls, fmt.Sprintf("UpdateAutoScalingGroup %s", aws.StringValue(input.LaunchConfigurationName)))
+			if input.LaunchConfigurationName != nil {
+				g.LaunchConfigurationName = input.LaunchConfigurationName
+			}
+		case *autoscaling.TerminateInstanceInAutoScalingGroupInput:
+			calls = append(calls, fmt.Sprintf("TerminateInstanceInAutoScalingGroup %s", aws.StringValue(input.InstanceId)))
+			// The ASG immediately launches a healthy replacement
+			var instances []*autoscaling.Instance
+			for _, i := range g.Instances {
+				if aws.StringValue(i.InstanceId) != aws.StringValue(input.InstanceId) {
+					instances = append(instances, i)
+				}
+			}
+			g.Instances = append(instances, &autoscaling.Instance{
+				InstanceId:              aws.String(fmt.Sprintf("i-%d", len(calls)+2)),
+				LaunchConfigurationName: g.LaunchConfigurationName,
+				LifecycleState:          aws.String("InService"),
+				HealthStatus:            aws.String("Healthy"),
+			})
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	d := resourceAwsAutoscalingGroup().Data(&terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"launch_configuration":                    "old",
+			"wait_for_capacity_timeout":               "1m",
+			"rolling_update.#":                        "1",
+			"rolling_update.0.max_batch_size":         "1",
+			"rolling_update.0.max_batch_percentage":   "0",
+			"rolling_update.0.min_healthy_percentage": "50",
+			"rolling_update.0.pause_time":             "0s",
+		},
+	})
+	d.Set("launch_configuration", "new")
+
+	if err := rollbackAutoscalingGroupUpdate(d, &AWSClient{autoscalingconn: conn}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"UpdateAutoScalingGroup old",
+		"TerminateInstanceInAutoScalingGroup i-2",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("bad calls: %#v", calls)
+	}
+	if outdated := autoscalingGroupOutdatedInstanceIds(g, nil); len(outdated) != 0 {
+		t.Fatalf("expected all instances on launch configuration old, got outdated: %v", outdated)
+	}
+}
@@ -363,6 +363,37 @@ func TestAccAWSAutoScalingGroup_WithLoadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_rollingUpdate(t *testing.T) {
+	var group autoscaling.Group
+
+	randName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_rollingUpdate(randName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					testAccCheckAWSAutoScalingGroupHealthyCapacity(&group, 2),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "rolling_update.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "rolling_update.0.max_batch_size", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "rolling_update.0.min_healthy_percentage", "50"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_rollingUpdate(randName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					testAccCheckAWSAutoScalingGroupHealthyCapacity(&group, 2),
+					testAccCheckAWSAutoScalingGroupInstancesUpToDate(&group),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_withPlacementGroup(t *testing.T) {
 	var group autoscaling.Group
 
@@ -806,6 +837,19 @@ func testAccCheckAWSAutoScalingGroupHealthyCapacity(
 	}
 }
 
+func testAccCheckAWSAutoScalingGroupInstancesUpToDate(g *autoscaling.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		lt, err := getAutoscalingGroupLaunchTemplate(testAccProvider.Meta().(*AWSClient).ec2conn, g)
+		if err != nil {
+			return err
+		}
+		if ids := autoscalingGroupOutdatedInstanceIds(g, lt); len(ids) > 0 {
+			return fmt.Errorf("Expected all instances to use the current launch configuration, outdated: %v", ids)
+		}
+		return nil
+	}
+}
+
 func testAccCheckAWSAutoScalingGroupAttributesVPCZoneIdentifer(group *autoscaling.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Grab Subnet Ids
@@ -1411,6 +1455,48 @@ resource "aws_autoscaling_group" "bar" {
 }
 `
 
+func testAccAWSAutoScalingGroupConfig_rollingUpdate(name, instanceType string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_configuration" "foobar" {
+  name_prefix   = "%[1]s"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "%[2]s"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_autoscaling_group" "bar" {
+  name                 = "%[1]s"
+  availability_zones   = ["us-west-2a"]
+  max_size             = 2
+  min_size             = 2
+  force_delete         = true
+  launch_configuration = "${aws_launch_configuration.foobar.name}"
+
+  rolling_update {
+    max_batch_size         = 1
+    min_healthy_percentage = 50
+  }
+}
+`, name, instanceType)
+}
+
 const testAccAWSAutoScalingGroupConfigWithAZ = `
 resource "aws_vpc" "default" {
   cidr_block = "10.0.0.0/16"
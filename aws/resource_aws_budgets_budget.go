@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsBudgetsBudget() *schema.Resource {
@@ -130,6 +131,53 @@ func resourceAwsBudgetsBudget() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"notification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorEqualTo,
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"subscriber_email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subscriber_sns_topic_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 		Create: resourceAwsBudgetsBudgetCreate,
 		Read:   resourceAwsBudgetsBudgetRead,
@@ -171,6 +219,13 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", accountID, *budget.BudgetName))
+
+	for _, n := range d.Get("notification").(*schema.Set).List() {
+		if err := createBudgetsNotification(client, accountID, *budget.BudgetName, n.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsBudgetsBudgetRead(d, meta)
 }
 
@@ -227,11 +282,20 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("time_unit", budget.TimeUnit)
 
+	notifications, err := flattenBudgetsNotifications(client, accountID, budgetName)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("notification", notifications); err != nil {
+		return fmt.Errorf("error setting notification: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
-	accountID, _, err := decodeBudgetsBudgetID(d.Id())
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -250,6 +314,13 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("update budget failed: %v", err)
 	}
 
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		if err := updateBudgetsNotifications(client, accountID, budgetName, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsBudgetsBudgetRead(d, meta)
 }
 
@@ -297,6 +368,248 @@ func flattenBudgetsCostTypes(costTypes *budgets.CostTypes) []map[string]interfac
 	return []map[string]interface{}{m}
 }
 
+func flattenBudgetsNotifications(client *budgets.Budgets, accountID, budgetName string) ([]map[string]interface{}, error) {
+	var notifications []*budgets.Notification
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	}
+	for {
+		out, err := client.DescribeNotificationsForBudget(input)
+		if err != nil {
+			return nil, fmt.Errorf("describe notifications for budget %s failed: %v", budgetName, err)
+		}
+		notifications = append(notifications, out.Notifications...)
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	result := make([]map[string]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		var emailAddresses, snsTopicArns []string
+		subscribersInput := &budgets.DescribeSubscribersForNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+		}
+		for {
+			out, err := client.DescribeSubscribersForNotification(subscribersInput)
+			if err != nil {
+				return nil, fmt.Errorf("describe subscribers for budget %s notification failed: %v", budgetName, err)
+			}
+			for _, subscriber := range out.Subscribers {
+				switch aws.StringValue(subscriber.SubscriptionType) {
+				case budgets.SubscriptionTypeEmail:
+					emailAddresses = append(emailAddresses, aws.StringValue(subscriber.Address))
+				case budgets.SubscriptionTypeSns:
+					snsTopicArns = append(snsTopicArns, aws.StringValue(subscriber.Address))
+				}
+			}
+			if out.NextToken == nil {
+				break
+			}
+			subscribersInput.NextToken = out.NextToken
+		}
+
+		result = append(result, map[string]interface{}{
+			"comparison_operator":        aws.StringValue(notification.ComparisonOperator),
+			"threshold":                  aws.Float64Value(notification.Threshold),
+			"threshold_type":             aws.StringValue(notification.ThresholdType),
+			"notification_type":          aws.StringValue(notification.NotificationType),
+			"subscriber_email_addresses": schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(emailAddresses))),
+			"subscriber_sns_topic_arns":  schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(snsTopicArns))),
+		})
+	}
+
+	return result, nil
+}
+
+func createBudgetsNotification(client *budgets.Budgets, accountID, budgetName string, m map[string]interface{}) error {
+	notification := expandBudgetsNotification(m)
+	subscribers := expandBudgetsSubscribers(m)
+	if len(subscribers) == 0 {
+		return fmt.Errorf("budget notification must have at least one subscriber_email_addresses or subscriber_sns_topic_arns entry")
+	}
+
+	_, err := client.CreateNotification(&budgets.CreateNotificationInput{
+		AccountId:    aws.String(accountID),
+		BudgetName:   aws.String(budgetName),
+		Notification: notification,
+		Subscribers:  subscribers,
+	})
+	if err != nil {
+		return fmt.Errorf("create budget notification failed: %v", err)
+	}
+
+	return nil
+}
+
+// updateBudgetsNotifications reconciles the notification set. Set elements
+// whose notification is unchanged but whose subscribers differ only have
+// their subscribers updated; other changed elements are paired up and
+// updated in place, and any remainder is created or deleted.
+func updateBudgetsNotifications(client *budgets.Budgets, accountID, budgetName string, o, n *schema.Set) error {
+	removed := o.Difference(n).List()
+	added := n.Difference(o).List()
+
+	matched := make(map[int]bool)
+	var unmatchedAdded []map[string]interface{}
+	for _, a := range added {
+		am := a.(map[string]interface{})
+		found := false
+		for i, r := range removed {
+			rm := r.(map[string]interface{})
+			if matched[i] || budgetsNotificationKey(rm) != budgetsNotificationKey(am) {
+				continue
+			}
+			matched[i] = true
+			found = true
+			if err := updateBudgetsSubscribers(client, accountID, budgetName, expandBudgetsNotification(am), rm, am); err != nil {
+				return err
+			}
+			break
+		}
+		if !found {
+			unmatchedAdded = append(unmatchedAdded, am)
+		}
+	}
+
+	var unmatchedRemoved []map[string]interface{}
+	for i, r := range removed {
+		if !matched[i] {
+			unmatchedRemoved = append(unmatchedRemoved, r.(map[string]interface{}))
+		}
+	}
+
+	for len(unmatchedRemoved) > 0 && len(unmatchedAdded) > 0 {
+		rm, am := unmatchedRemoved[0], unmatchedAdded[0]
+		unmatchedRemoved, unmatchedAdded = unmatchedRemoved[1:], unmatchedAdded[1:]
+
+		newNotification := expandBudgetsNotification(am)
+		_, err := client.UpdateNotification(&budgets.UpdateNotificationInput{
+			AccountId:       aws.String(accountID),
+			BudgetName:      aws.String(budgetName),
+			OldNotification: expandBudgetsNotification(rm),
+			NewNotification: newNotification,
+		})
+		if err != nil {
+			return fmt.Errorf("update budget notification failed: %v", err)
+		}
+
+		if err := updateBudgetsSubscribers(client, accountID, budgetName, newNotification, rm, am); err != nil {
+			return err
+		}
+	}
+
+	for _, rm := range unmatchedRemoved {
+		_, err := client.DeleteNotification(&budgets.DeleteNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: expandBudgetsNotification(rm),
+		})
+		if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return fmt.Errorf("delete budget notification failed: %v", err)
+		}
+	}
+
+	for _, am := range unmatchedAdded {
+		if err := createBudgetsNotification(client, accountID, budgetName, am); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateBudgetsSubscribers adds new subscribers before removing old ones,
+// as a notification must always keep at least one subscriber.
+func updateBudgetsSubscribers(client *budgets.Budgets, accountID, budgetName string, notification *budgets.Notification, o, n map[string]interface{}) error {
+	oldSubscribers := make(map[string]*budgets.Subscriber)
+	for _, subscriber := range expandBudgetsSubscribers(o) {
+		oldSubscribers[budgetsSubscriberKey(subscriber)] = subscriber
+	}
+	newSubscribers := make(map[string]*budgets.Subscriber)
+	for _, subscriber := range expandBudgetsSubscribers(n) {
+		newSubscribers[budgetsSubscriberKey(subscriber)] = subscriber
+	}
+
+	if len(newSubscribers) == 0 {
+		return fmt.Errorf("budget notification must have at least one subscriber_email_addresses or subscriber_sns_topic_arns entry")
+	}
+
+	for k, subscriber := range newSubscribers {
+		if _, ok := oldSubscribers[k]; ok {
+			continue
+		}
+		_, err := client.CreateSubscriber(&budgets.CreateSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+			Subscriber:   subscriber,
+		})
+		if err != nil {
+			return fmt.Errorf("create budget subscriber %s failed: %v", aws.StringValue(subscriber.Address), err)
+		}
+	}
+
+	for k, subscriber := range oldSubscribers {
+		if _, ok := newSubscribers[k]; ok {
+			continue
+		}
+		_, err := client.DeleteSubscriber(&budgets.DeleteSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+			Subscriber:   subscriber,
+		})
+		if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return fmt.Errorf("delete budget subscriber %s failed: %v", aws.StringValue(subscriber.Address), err)
+		}
+	}
+
+	return nil
+}
+
+func budgetsNotificationKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%s:%g:%s:%s", m["comparison_operator"].(string), m["threshold"].(float64), m["threshold_type"].(string), m["notification_type"].(string))
+}
+
+func budgetsSubscriberKey(subscriber *budgets.Subscriber) string {
+	return fmt.Sprintf("%s:%s", aws.StringValue(subscriber.SubscriptionType), aws.StringValue(subscriber.Address))
+}
+
+func expandBudgetsNotification(m map[string]interface{}) *budgets.Notification {
+	return &budgets.Notification{
+		ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+		NotificationType:   aws.String(m["notification_type"].(string)),
+		Threshold:          aws.Float64(m["threshold"].(float64)),
+		ThresholdType:      aws.String(m["threshold_type"].(string)),
+	}
+}
+
+func expandBudgetsSubscribers(m map[string]interface{}) []*budgets.Subscriber {
+	var subscribers []*budgets.Subscriber
+	if v, ok := m["subscriber_email_addresses"].(*schema.Set); ok {
+		for _, address := range v.List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			})
+		}
+	}
+	if v, ok := m["subscriber_sns_topic_arns"].(*schema.Set); ok {
+		for _, arn := range v.List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(arn.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			})
+		}
+	}
+	return subscribers
+}
+
 func convertCostFiltersToStringMap(costFilters map[string][]*string) map[string]string {
 	convertedCostFilters := make(map[string]string)
 	for k, v := range costFilters {
@@ -105,6 +105,67 @@ func TestAccAWSBudgetsBudget_prefix(t *testing.T) {
 	})
 }
 
+func TestAccAWSBudgetsBudget_notification(t *testing.T) {
+	resourceName := "aws_budgets_budget.foo"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(rName, 80, `subscriber_email_addresses = ["test1@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+					testAccAWSBudgetsBudgetNotificationCount(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(rName, 80, `subscriber_email_addresses = ["test2@example.com"]
+    subscriber_sns_topic_arns  = ["${aws_sns_topic.test.arn}"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+					testAccAWSBudgetsBudgetNotificationCount(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(rName, 100, `subscriber_sns_topic_arns = ["${aws_sns_topic.test.arn}"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+					testAccAWSBudgetsBudgetNotificationCount(resourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetNotificationCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		accountID, budgetName, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*AWSClient).budgetconn
+		notifications, err := flattenBudgetsNotifications(client, accountID, budgetName)
+		if err != nil {
+			return err
+		}
+
+		if len(notifications) != expected {
+			return fmt.Errorf("Expected %d budget notifications, got %d", expected, len(notifications))
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSBudgetsBudgetExists(resourceName string, config budgets.Budget) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -410,3 +471,28 @@ resource "aws_budgets_budget" "foo" {
 	t.Execute(&doc, budgetConfig)
 	return doc.String()
 }
+
+func testAccAWSBudgetsBudgetConfig_Notification(rName string, threshold int, subscribers string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_budgets_budget" "foo" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator = "GREATER_THAN"
+    threshold           = %[2]d
+    threshold_type      = "PERCENTAGE"
+    notification_type   = "FORECASTED"
+    %[3]s
+  }
+}
+`, rName, threshold, subscribers)
+}
@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCodePipelineWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodePipelineWebhookCreate,
+		Read:   resourceAwsCodePipelineWebhookRead,
+		Update: resourceAwsCodePipelineWebhookUpdate,
+		Delete: resourceAwsCodePipelineWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"authentication": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					codepipeline.WebhookAuthenticationTypeGithubHmac,
+					codepipeline.WebhookAuthenticationTypeIp,
+					codepipeline.WebhookAuthenticationTypeUnauthenticated,
+				}, false),
+			},
+
+			"authentication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_ip_range": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+
+						"secret_token": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"match_equals": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"register_with_third_party": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"target_action": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_pipeline": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCodePipelineWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	webhook, err := resourceAwsCodePipelineWebhookPut(conn, d)
+	if err != nil {
+		return fmt.Errorf("error creating CodePipeline Webhook: %s", err)
+	}
+
+	d.SetId(aws.StringValue(webhook.Arn))
+
+	if d.Get("register_with_third_party").(bool) {
+		log.Printf("[DEBUG] Registering CodePipeline Webhook (%s) with third party", d.Id())
+		_, err := conn.RegisterWebhookWithThirdParty(&codepipeline.RegisterWebhookWithThirdPartyInput{
+			WebhookName: webhook.Definition.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("error registering CodePipeline Webhook (%s) with third party: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCodePipelineWebhookRead(d, meta)
+}
+
+func resourceAwsCodePipelineWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	webhook, err := getCodePipelineWebhook(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading CodePipeline Webhook (%s): %s", d.Id(), err)
+	}
+
+	if webhook == nil {
+		log.Printf("[WARN] CodePipeline Webhook %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	definition := webhook.Definition
+
+	d.Set("arn", webhook.Arn)
+	d.Set("authentication", definition.Authentication)
+	if err := d.Set("authentication_configuration", flattenCodePipelineWebhookAuthenticationConfiguration(definition.AuthenticationConfiguration, d)); err != nil {
+		return fmt.Errorf("error setting authentication_configuration: %s", err)
+	}
+	if err := d.Set("filter", flattenCodePipelineWebhookFilters(definition.Filters)); err != nil {
+		return fmt.Errorf("error setting filter: %s", err)
+	}
+	d.Set("name", definition.Name)
+	d.Set("target_action", definition.TargetAction)
+	d.Set("target_pipeline", definition.TargetPipeline)
+	d.Set("url", webhook.Url)
+
+	return nil
+}
+
+func resourceAwsCodePipelineWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	// PutWebhook replaces the definition of an existing webhook with the same name
+	if _, err := resourceAwsCodePipelineWebhookPut(conn, d); err != nil {
+		return fmt.Errorf("error updating CodePipeline Webhook (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCodePipelineWebhookRead(d, meta)
+}
+
+func resourceAwsCodePipelineWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+	name := d.Get("name").(string)
+
+	if d.Get("register_with_third_party").(bool) {
+		log.Printf("[DEBUG] Deregistering CodePipeline Webhook (%s) with third party", d.Id())
+		_, err := conn.DeregisterWebhookWithThirdParty(&codepipeline.DeregisterWebhookWithThirdPartyInput{
+			WebhookName: aws.String(name),
+		})
+		if err != nil && !isAWSErr(err, codepipeline.ErrCodeWebhookNotFoundException, "") {
+			return fmt.Errorf("error deregistering CodePipeline Webhook (%s) with third party: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting CodePipeline Webhook: %s", d.Id())
+	_, err := conn.DeleteWebhook(&codepipeline.DeleteWebhookInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, codepipeline.ErrCodeWebhookNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting CodePipeline Webhook (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCodePipelineWebhookPut(conn *codepipeline.CodePipeline, d *schema.ResourceData) (*codepipeline.ListWebhookItem, error) {
+	input := &codepipeline.PutWebhookInput{
+		Webhook: &codepipeline.WebhookDefinition{
+			Authentication:              aws.String(d.Get("authentication").(string)),
+			AuthenticationConfiguration: expandCodePipelineWebhookAuthenticationConfiguration(d.Get("authentication_configuration").([]interface{})),
+			Filters:                     expandCodePipelineWebhookFilters(d.Get("filter").(*schema.Set).List()),
+			Name:                        aws.String(d.Get("name").(string)),
+			TargetAction:                aws.String(d.Get("target_action").(string)),
+			TargetPipeline:              aws.String(d.Get("target_pipeline").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting CodePipeline Webhook: %s", input)
+	resp, err := conn.PutWebhook(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Webhook, nil
+}
+
+func getCodePipelineWebhook(conn *codepipeline.CodePipeline, arn string) (*codepipeline.ListWebhookItem, error) {
+	input := &codepipeline.ListWebhooksInput{}
+
+	for {
+		resp, err := conn.ListWebhooks(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, webhook := range resp.Webhooks {
+			if aws.StringValue(webhook.Arn) == arn {
+				return webhook, nil
+			}
+		}
+
+		if aws.StringValue(resp.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+func expandCodePipelineWebhookAuthenticationConfiguration(l []interface{}) *codepipeline.WebhookAuthConfiguration {
+	config := &codepipeline.WebhookAuthConfiguration{}
+
+	if len(l) == 0 || l[0] == nil {
+		return config
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["allowed_ip_range"].(string); ok && v != "" {
+		config.AllowedIPRange = aws.String(v)
+	}
+
+	if v, ok := m["secret_token"].(string); ok && v != "" {
+		config.SecretToken = aws.String(v)
+	}
+
+	return config
+}
+
+func expandCodePipelineWebhookFilters(l []interface{}) []*codepipeline.WebhookFilterRule {
+	filters := make([]*codepipeline.WebhookFilterRule, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		filters = append(filters, &codepipeline.WebhookFilterRule{
+			JsonPath:    aws.String(m["json_path"].(string)),
+			MatchEquals: aws.String(m["match_equals"].(string)),
+		})
+	}
+
+	return filters
+}
+
+func flattenCodePipelineWebhookAuthenticationConfiguration(config *codepipeline.WebhookAuthConfiguration, d *schema.ResourceData) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	allowedIPRange := aws.StringValue(config.AllowedIPRange)
+	secretToken := aws.StringValue(config.SecretToken)
+
+	// The secret token is not returned for GITHUB_HMAC, so keep the configured value
+	if config.SecretToken == nil {
+		secretToken = d.Get("authentication_configuration.0.secret_token").(string)
+	}
+
+	if allowedIPRange == "" && secretToken == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"allowed_ip_range": allowedIPRange,
+		"secret_token":     secretToken,
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCodePipelineWebhookFilters(filters []*codepipeline.WebhookFilterRule) []interface{} {
+	l := make([]interface{}, 0, len(filters))
+
+	for _, filter := range filters {
+		l = append(l, map[string]interface{}{
+			"json_path":    aws.StringValue(filter.JsonPath),
+			"match_equals": aws.StringValue(filter.MatchEquals),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFlattenCodePipelineWebhookAuthenticationConfiguration(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsCodePipelineWebhook().Schema, map[string]interface{}{
+		"authentication_configuration": []interface{}{
+			map[string]interface{}{
+				"secret_token": "super-secret",
+			},
+		},
+	})
+
+	cases := map[string]struct {
+		Config   *codepipeline.WebhookAuthConfiguration
+		Expected []interface{}
+	}{
+		"no configuration": {
+			Config:   nil,
+			Expected: []interface{}{},
+		},
+		"secret token not returned": {
+			Config: &codepipeline.WebhookAuthConfiguration{},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"allowed_ip_range": "",
+					"secret_token":     "super-secret",
+				},
+			},
+		},
+		"allowed IP range": {
+			Config: &codepipeline.WebhookAuthConfiguration{
+				AllowedIPRange: aws.String("10.0.0.0/8"),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"allowed_ip_range": "10.0.0.0/8",
+					"secret_token":     "super-secret",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		if got := flattenCodePipelineWebhookAuthenticationConfiguration(tc.Config, d); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %#v, got %#v", name, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccAWSCodePipelineWebhook_basic(t *testing.T) {
+	if os.Getenv("GITHUB_TOKEN") == "" {
+		t.Skip("Environment variable GITHUB_TOKEN is not set")
+	}
+
+	resourceName := "aws_codepipeline_webhook.test"
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodePipelineWebhookConfig(rName, "refs/heads/{Branch}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineWebhookExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authentication", "GITHUB_HMAC"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action", "Source"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authentication_configuration"},
+			},
+			{
+				Config: testAccAWSCodePipelineWebhookConfig(rName, "refs/heads/master"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCodePipelineWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodePipeline Webhook ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+
+		webhook, err := getCodePipelineWebhook(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if webhook == nil {
+			return fmt.Errorf("CodePipeline Webhook %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCodePipelineWebhookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codepipeline_webhook" {
+			continue
+		}
+
+		webhook, err := getCodePipelineWebhook(conn, rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, codepipeline.ErrCodeWebhookNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if webhook != nil {
+			return fmt.Errorf("CodePipeline Webhook %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCodePipelineWebhookConfig(rName, matchEquals string) string {
+	return testAccAWSCodePipelineConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_codepipeline_webhook" "test" {
+  name            = "test-webhook-%s"
+  authentication  = "GITHUB_HMAC"
+  target_action   = "Source"
+  target_pipeline = "${aws_codepipeline.bar.name}"
+
+  authentication_configuration {
+    secret_token = "super-secret"
+  }
+
+  filter {
+    json_path    = "$.ref"
+    match_equals = %q
+  }
+}
+`, rName, matchEquals)
+}
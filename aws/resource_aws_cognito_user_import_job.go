@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoUserImportJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserImportJobCreate,
+		Read:   resourceAwsCognitoUserImportJobRead,
+		Delete: resourceAwsCognitoUserImportJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserImportJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateUserImportJob.html
+		Schema: map[string]*schema.Schema{
+			"cloudwatch_logs_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"csv_s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"csv_s3_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"failed_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"imported_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"skipped_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserImportJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	s3conn := meta.(*AWSClient).s3conn
+	userPoolId := d.Get("user_pool_id").(string)
+
+	// Fetch the CSV before creating the job so that a missing object does not leave an orphaned job
+	log.Print("[DEBUG] Reading Cognito User Import Job CSV from S3")
+	object, err := s3conn.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(d.Get("csv_s3_bucket").(string)),
+		Key:    aws.String(d.Get("csv_s3_key").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Cognito User Import Job CSV from S3: %s", err)
+	}
+	defer object.Body.Close()
+
+	params := &cognitoidentityprovider.CreateUserImportJobInput{
+		CloudWatchLogsRoleArn: aws.String(d.Get("cloudwatch_logs_role_arn").(string)),
+		JobName:               aws.String(d.Get("job_name").(string)),
+		UserPoolId:            aws.String(userPoolId),
+	}
+
+	log.Print("[DEBUG] Creating Cognito User Import Job")
+
+	resp, err := conn.CreateUserImportJob(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito User Import Job: %s", err)
+	}
+
+	jobId := aws.StringValue(resp.UserImportJob.JobId)
+	d.SetId(fmt.Sprintf("%s/%s", userPoolId, jobId))
+	d.Set("job_id", jobId)
+
+	log.Print("[DEBUG] Uploading Cognito User Import Job CSV")
+
+	req, err := http.NewRequest(http.MethodPut, aws.StringValue(resp.UserImportJob.PreSignedUrl), object.Body)
+	if err != nil {
+		return fmt.Errorf("Error uploading Cognito User Import Job CSV: %s", err)
+	}
+	req.ContentLength = aws.Int64Value(object.ContentLength)
+	req.Header.Set("Content-Type", "text/csv")
+	req.Header.Set("x-amz-server-side-encryption", "aws:kms")
+
+	httpResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error uploading Cognito User Import Job CSV: %s", err)
+	}
+	httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error uploading Cognito User Import Job CSV: unexpected status %s", httpResp.Status)
+	}
+
+	log.Print("[DEBUG] Starting Cognito User Import Job")
+
+	_, err = conn.StartUserImportJob(&cognitoidentityprovider.StartUserImportJobInput{
+		JobId:      aws.String(jobId),
+		UserPoolId: aws.String(userPoolId),
+	})
+	if err != nil {
+		return fmt.Errorf("Error starting Cognito User Import Job: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cognitoidentityprovider.UserImportJobStatusTypeCreated,
+			cognitoidentityprovider.UserImportJobStatusTypePending,
+			cognitoidentityprovider.UserImportJobStatusTypeInProgress,
+		},
+		Target:     []string{cognitoidentityprovider.UserImportJobStatusTypeSucceeded},
+		Refresh:    cognitoUserImportJobStateRefreshFunc(conn, userPoolId, jobId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Cognito User Import Job (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoUserImportJobRead(d, meta)
+}
+
+func resourceAwsCognitoUserImportJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DescribeUserImportJobInput{
+		JobId:      aws.String(d.Get("job_id").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Print("[DEBUG] Reading Cognito User Import Job")
+
+	resp, err := conn.DescribeUserImportJob(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito User Import Job %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito User Import Job: %s", err)
+	}
+
+	job := resp.UserImportJob
+
+	d.Set("cloudwatch_logs_role_arn", job.CloudWatchLogsRoleArn)
+	if job.CompletionDate != nil {
+		d.Set("completion_date", job.CompletionDate.Format(time.RFC3339))
+	}
+	d.Set("completion_message", job.CompletionMessage)
+	if job.CreationDate != nil {
+		d.Set("creation_date", job.CreationDate.Format(time.RFC3339))
+	}
+	d.Set("failed_users", job.FailedUsers)
+	d.Set("imported_users", job.ImportedUsers)
+	d.Set("job_id", job.JobId)
+	d.Set("job_name", job.JobName)
+	d.Set("skipped_users", job.SkippedUsers)
+	d.Set("status", job.Status)
+	d.Set("user_pool_id", job.UserPoolId)
+
+	return nil
+}
+
+func resourceAwsCognitoUserImportJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// Import jobs cannot be deleted, only stopped while they are still running
+	switch d.Get("status").(string) {
+	case cognitoidentityprovider.UserImportJobStatusTypePending, cognitoidentityprovider.UserImportJobStatusTypeInProgress:
+		log.Print("[DEBUG] Stopping Cognito User Import Job")
+
+		_, err := conn.StopUserImportJob(&cognitoidentityprovider.StopUserImportJobInput{
+			JobId:      aws.String(d.Get("job_id").(string)),
+			UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+		})
+		if err != nil && !isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("Error stopping Cognito User Import Job: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserImportJobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), "/")
+	if len(idSplit) != 2 {
+		return nil, errors.New("Error importing Cognito User Import Job. Must specify user_pool_id/job_id")
+	}
+	d.Set("user_pool_id", idSplit[0])
+	d.Set("job_id", idSplit[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func cognitoUserImportJobStateRefreshFunc(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolId, jobId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeUserImportJob(&cognitoidentityprovider.DescribeUserImportJobInput{
+			JobId:      aws.String(jobId),
+			UserPoolId: aws.String(userPoolId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		job := resp.UserImportJob
+		status := aws.StringValue(job.Status)
+
+		switch status {
+		case cognitoidentityprovider.UserImportJobStatusTypeFailed,
+			cognitoidentityprovider.UserImportJobStatusTypeStopped,
+			cognitoidentityprovider.UserImportJobStatusTypeExpired:
+			return job, status, fmt.Errorf("job %s: %s", strings.ToLower(status), aws.StringValue(job.CompletionMessage))
+		}
+
+		return job, status, nil
+	}
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserImportJob_basic(t *testing.T) {
+	resourceName := "aws_cognito_user_import_job.main"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserImportJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserImportJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failed_users", "0"),
+					resource.TestCheckResourceAttr(resourceName, "imported_users", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Succeeded"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"csv_s3_bucket", "csv_s3_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserImportJobExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito User Import Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err := conn.DescribeUserImportJob(&cognitoidentityprovider.DescribeUserImportJobInput{
+			JobId:      aws.String(rs.Primary.Attributes["job_id"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCognitoUserImportJobConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_iam_role" "main" {
+  name = "%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "cognito-idp.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "main" {
+  name = "%[1]s"
+  role = "${aws_iam_role.main.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:DescribeLogStreams",
+        "logs:PutLogEvents"
+      ],
+      "Resource": "arn:aws:logs:*:*:log-group:/aws/cognito/*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "main" {
+  bucket        = "%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "main" {
+  bucket = "${aws_s3_bucket.main.id}"
+  key    = "users.csv"
+
+  content = <<EOF
+name,given_name,family_name,middle_name,nickname,preferred_username,profile,picture,website,email,email_verified,gender,birthdate,zoneinfo,locale,phone_number,phone_number_verified,address,updated_at,cognito:mfa_enabled,cognito:username
+,,,,,,,,,user1@example.com,true,,,,,,false,,,false,user1
+,,,,,,,,,user2@example.com,true,,,,,,false,,,false,user2
+EOF
+}
+
+resource "aws_cognito_user_import_job" "main" {
+  cloudwatch_logs_role_arn = "${aws_iam_role.main.arn}"
+  csv_s3_bucket            = "${aws_s3_bucket_object.main.bucket}"
+  csv_s3_key               = "${aws_s3_bucket_object.main.key}"
+  job_name                 = "%[1]s"
+  user_pool_id             = "${aws_cognito_user_pool.main.id}"
+
+  depends_on = ["aws_iam_role_policy.main"]
+}
+`, rName)
+}
@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoUserPoolUICustomization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolUICustomizationPut,
+		Read:   resourceAwsCognitoUserPoolUICustomizationRead,
+		Update: resourceAwsCognitoUserPoolUICustomizationPut,
+		Delete: resourceAwsCognitoUserPoolUICustomizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserPoolUICustomizationImport,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_SetUICustomization.html
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ALL",
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"css": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"css_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(d.Get("client_id").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if v, ok := d.GetOk("css"); ok {
+		params.CSS = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_file"); ok {
+		imageFile, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error decoding Cognito User Pool UI Customization image_file: %s", err)
+		}
+		params.ImageFile = imageFile
+	}
+
+	log.Print("[DEBUG] Setting Cognito User Pool UI Customization")
+
+	resp, err := conn.SetUICustomization(params)
+	if err != nil {
+		return fmt.Errorf("Error setting Cognito User Pool UI Customization: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *resp.UICustomization.UserPoolId, *resp.UICustomization.ClientId))
+
+	return resourceAwsCognitoUserPoolUICustomizationRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.GetUICustomizationInput{
+		ClientId:   aws.String(d.Get("client_id").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Print("[DEBUG] Reading Cognito User Pool UI Customization")
+
+	resp, err := conn.GetUICustomization(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito User Pool UI Customization %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito User Pool UI Customization: %s", err)
+	}
+
+	customization := resp.UICustomization
+
+	d.Set("client_id", customization.ClientId)
+	if customization.CreationDate != nil {
+		d.Set("creation_date", customization.CreationDate.Format(time.RFC3339))
+	}
+	d.Set("css", customization.CSS)
+	d.Set("css_version", customization.CSSVersion)
+	d.Set("image_url", customization.ImageUrl)
+	if customization.LastModifiedDate != nil {
+		d.Set("last_modified_date", customization.LastModifiedDate.Format(time.RFC3339))
+	}
+	d.Set("user_pool_id", customization.UserPoolId)
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// Setting neither CSS nor an image restores the default hosted UI
+	params := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(d.Get("client_id").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Print("[DEBUG] Removing Cognito User Pool UI Customization")
+
+	_, err := conn.SetUICustomization(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing Cognito User Pool UI Customization: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.Split(d.Id(), "/")
+	if len(idSplit) != 2 {
+		return nil, errors.New("Error importing Cognito User Pool UI Customization. Must specify user_pool_id/client_id")
+	}
+	d.Set("user_pool_id", idSplit[0])
+	d.Set("client_id", idSplit[1])
+	return []*schema.ResourceData{d}, nil
+}
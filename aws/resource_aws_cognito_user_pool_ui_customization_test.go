@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserPoolUICustomization_basic(t *testing.T) {
+	resourceName := "aws_cognito_user_pool_ui_customization.main"
+	domainName := fmt.Sprintf("tf-acc-test-domain-%d", acctest.RandInt())
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_basic(domainName, poolName, "red"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_id", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 400; color: red;}"),
+					resource.TestCheckResourceAttrSet(resourceName, "css_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_basic(domainName, poolName, "blue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 400; color: blue;}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_client(t *testing.T) {
+	resourceName := "aws_cognito_user_pool_ui_customization.main"
+	domainName := fmt.Sprintf("tf-acc-test-domain-%d", acctest.RandInt())
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_client(domainName, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.main", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito User Pool UI Customization ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		resp, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+			ClientId:   aws.String(rs.Primary.Attributes["client_id"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.UICustomization.CSS == nil {
+			return fmt.Errorf("Cognito User Pool UI Customization %s has no CSS", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_ui_customization" {
+			continue
+		}
+
+		idSplit := strings.Split(rs.Primary.ID, "/")
+
+		resp, err := conn.GetUICustomization(&cognitoidentityprovider.GetUICustomizationInput{
+			ClientId:   aws.String(idSplit[1]),
+			UserPoolId: aws.String(idSplit[0]),
+		})
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if resp.UICustomization.CSS != nil {
+			return fmt.Errorf("Cognito User Pool UI Customization %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_base(domainName, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+
+resource "aws_cognito_user_pool_domain" "main" {
+  domain       = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+`, poolName, domainName)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_basic(domainName, poolName, color string) string {
+	return testAccAWSCognitoUserPoolUICustomizationConfig_base(domainName, poolName) + fmt.Sprintf(`
+resource "aws_cognito_user_pool_ui_customization" "main" {
+  css          = ".label-customizable {font-weight: 400; color: %s;}"
+  user_pool_id = "${aws_cognito_user_pool_domain.main.user_pool_id}"
+}
+`, color)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_client(domainName, poolName string) string {
+	return testAccAWSCognitoUserPoolUICustomizationConfig_base(domainName, poolName) + `
+resource "aws_cognito_user_pool_client" "main" {
+  name         = "client"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool_ui_customization" "main" {
+  client_id    = "${aws_cognito_user_pool_client.main.id}"
+  css          = ".label-customizable {font-weight: 400;}"
+  user_pool_id = "${aws_cognito_user_pool_domain.main.user_pool_id}"
+}
+`
+}
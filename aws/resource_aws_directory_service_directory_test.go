@@ -46,9 +46,7 @@ func TestDiffTagsDirectoryService(t *testing.T) {
 			Create: map[string]string{
 				"foo": "baz",
 			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
+			Remove: map[string]string{},
 		},
 	}
 
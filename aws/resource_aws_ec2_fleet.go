@@ -0,0 +1,636 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Fleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2FleetCreate,
+		Read:   resourceAwsEc2FleetRead,
+		Update: resourceAwsEc2FleetUpdate,
+		Delete: resourceAwsEc2FleetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"excess_capacity_termination_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.FleetExcessCapacityTerminationPolicyTermination,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetExcessCapacityTerminationPolicyNoTermination,
+					ec2.FleetExcessCapacityTerminationPolicyTermination,
+				}, false),
+			},
+			"launch_template_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_template_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"launch_template_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"launch_template_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"weighted_capacity": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replace_unhealthy_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"spot_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocation_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.SpotAllocationStrategyLowestPrice,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotAllocationStrategyDiversified,
+								ec2.SpotAllocationStrategyLowestPrice,
+							}, false),
+						},
+						"instance_interruption_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.SpotInstanceInterruptionBehaviorTerminate,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotInstanceInterruptionBehaviorHibernate,
+								ec2.SpotInstanceInterruptionBehaviorStop,
+								ec2.SpotInstanceInterruptionBehaviorTerminate,
+							}, false),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"target_capacity_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_target_capacity_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.DefaultTargetCapacityTypeOnDemand,
+								ec2.DefaultTargetCapacityTypeSpot,
+							}, false),
+						},
+						"on_demand_target_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"spot_target_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"total_target_capacity": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"terminate_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"terminate_instances_with_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.FleetTypeMaintain,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetTypeMaintain,
+					ec2.FleetTypeRequest,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateFleetInput{
+		ExcessCapacityTerminationPolicy:  aws.String(d.Get("excess_capacity_termination_policy").(string)),
+		LaunchTemplateConfigs:            expandEc2FleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{})),
+		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
+		SpotOptions:                      expandEc2SpotOptionsRequest(d.Get("spot_options").([]interface{})),
+		TargetCapacitySpecification:      expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
+		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
+		Type:                             aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.TagSpecifications = []*ec2.TagSpecification{
+			{
+				// The SDK has no ResourceType constant for fleets yet
+				ResourceType: aws.String("fleet"),
+				Tags:         tagsFromMap(v.(map[string]interface{})),
+			},
+		}
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Fleet: %s", input)
+	output, err := conn.CreateFleet(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Fleet: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FleetId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FleetStateCodeSubmitted},
+		Target:  []string{ec2.FleetStateCodeActive},
+		Refresh: ec2FleetRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Fleet (%s) activation", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Fleet (%s) activation: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2FleetRead(d, meta)
+}
+
+func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	fleet, err := getEc2Fleet(conn, d.Id())
+	if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Fleet (%s): %s", d.Id(), err)
+	}
+
+	if fleet == nil {
+		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(fleet.FleetState) {
+	case ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating:
+		log.Printf("[WARN] EC2 Fleet (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(fleet.FleetState))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+
+	if err := d.Set("launch_template_config", flattenEc2FleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
+		return fmt.Errorf("error setting launch_template_config: %s", err)
+	}
+
+	d.Set("replace_unhealthy_instances", fleet.ReplaceUnhealthyInstances)
+
+	if err := d.Set("spot_options", flattenEc2SpotOptions(fleet.SpotOptions)); err != nil {
+		return fmt.Errorf("error setting spot_options: %s", err)
+	}
+
+	if err := d.Set("target_capacity_specification", flattenEc2TargetCapacitySpecification(fleet.TargetCapacitySpecification)); err != nil {
+		return fmt.Errorf("error setting target_capacity_specification: %s", err)
+	}
+
+	d.Set("terminate_instances_with_expiration", fleet.TerminateInstancesWithExpiration)
+	d.Set("type", fleet.Type)
+
+	if err := d.Set("tags", tagsToMap(fleet.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("excess_capacity_termination_policy") || d.HasChange("target_capacity_specification") {
+		input := &ec2.ModifyFleetInput{
+			ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
+			FleetId:                         aws.String(d.Id()),
+			TargetCapacitySpecification:     expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Fleet: %s", input)
+		if _, err := conn.ModifyFleet(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Fleet (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{ec2.FleetStateCodeModifying},
+			Target:  []string{ec2.FleetStateCodeActive},
+			Refresh: ec2FleetRefreshFunc(conn, d.Id()),
+			Timeout: d.Timeout(schema.TimeoutUpdate),
+		}
+
+		log.Printf("[DEBUG] Waiting for EC2 Fleet (%s) modification", d.Id())
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for EC2 Fleet (%s) modification: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		if err := setTags(conn, d); err != nil {
+			return fmt.Errorf("error updating EC2 Fleet (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2FleetRead(d, meta)
+}
+
+func resourceAwsEc2FleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	terminateInstances := d.Get("terminate_instances").(bool)
+
+	input := &ec2.DeleteFleetsInput{
+		FleetIds:           []*string{aws.String(d.Id())},
+		TerminateInstances: aws.Bool(terminateInstances),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Fleet: %s", input)
+	output, err := conn.DeleteFleets(input)
+	if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Fleet (%s): %s", d.Id(), err)
+	}
+
+	if output != nil && len(output.UnsuccessfulFleetDeletions) > 0 {
+		deleteErr := output.UnsuccessfulFleetDeletions[0].Error
+		if deleteErr != nil {
+			return fmt.Errorf("error deleting EC2 Fleet (%s): %s: %s", d.Id(), aws.StringValue(deleteErr.Code), aws.StringValue(deleteErr.Message))
+		}
+	}
+
+	// Only wait for instance termination if requested
+	if !terminateInstances {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.FleetStateCodeActive,
+			ec2.FleetStateCodeDeletedTerminating,
+			ec2.FleetStateCodeModifying,
+			ec2.FleetStateCodeSubmitted,
+		},
+		Target:  []string{ec2.FleetStateCodeDeleted},
+		Refresh: ec2FleetRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Fleet (%s) deletion", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Fleet (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getEc2Fleet(conn *ec2.EC2, id string) (*ec2.FleetData, error) {
+	output, err := conn.DescribeFleets(&ec2.DescribeFleetsInput{
+		FleetIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fleet := range output.Fleets {
+		if aws.StringValue(fleet.FleetId) == id {
+			return fleet, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2FleetRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := getEc2Fleet(conn, id)
+		if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+			return id, ec2.FleetStateCodeDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Deleted fleets eventually disappear from the API
+		if fleet == nil {
+			return id, ec2.FleetStateCodeDeleted, nil
+		}
+
+		state := aws.StringValue(fleet.FleetState)
+		if state == ec2.FleetStateCodeFailed {
+			return fleet, state, fmt.Errorf("EC2 Fleet (%s) failed", id)
+		}
+
+		return fleet, state, nil
+	}
+}
+
+func expandEc2FleetLaunchTemplateConfigRequests(l []interface{}) []*ec2.FleetLaunchTemplateConfigRequest {
+	configs := make([]*ec2.FleetLaunchTemplateConfigRequest, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		config := &ec2.FleetLaunchTemplateConfigRequest{
+			LaunchTemplateSpecification: expandEc2FleetLaunchTemplateSpecificationRequest(m["launch_template_specification"].([]interface{})),
+		}
+
+		if v, ok := m["override"].([]interface{}); ok && len(v) > 0 {
+			config.Overrides = expandEc2FleetLaunchTemplateOverridesRequests(v)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+func expandEc2FleetLaunchTemplateSpecificationRequest(l []interface{}) *ec2.FleetLaunchTemplateSpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	spec := &ec2.FleetLaunchTemplateSpecificationRequest{
+		Version: aws.String(m["version"].(string)),
+	}
+
+	if v, ok := m["launch_template_id"].(string); ok && v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	}
+
+	if v, ok := m["launch_template_name"].(string); ok && v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+
+	return spec
+}
+
+func expandEc2FleetLaunchTemplateOverridesRequests(l []interface{}) []*ec2.FleetLaunchTemplateOverridesRequest {
+	overrides := make([]*ec2.FleetLaunchTemplateOverridesRequest, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		override := &ec2.FleetLaunchTemplateOverridesRequest{}
+
+		if v, ok := m["availability_zone"].(string); ok && v != "" {
+			override.AvailabilityZone = aws.String(v)
+		}
+
+		if v, ok := m["instance_type"].(string); ok && v != "" {
+			override.InstanceType = aws.String(v)
+		}
+
+		if v, ok := m["max_price"].(string); ok && v != "" {
+			override.MaxPrice = aws.String(v)
+		}
+
+		if v, ok := m["subnet_id"].(string); ok && v != "" {
+			override.SubnetId = aws.String(v)
+		}
+
+		if v, ok := m["weighted_capacity"].(float64); ok && v > 0 {
+			override.WeightedCapacity = aws.Float64(v)
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+func expandEc2SpotOptionsRequest(l []interface{}) *ec2.SpotOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ec2.SpotOptionsRequest{
+		AllocationStrategy:           aws.String(m["allocation_strategy"].(string)),
+		InstanceInterruptionBehavior: aws.String(m["instance_interruption_behavior"].(string)),
+	}
+}
+
+func expandEc2TargetCapacitySpecificationRequest(l []interface{}) *ec2.TargetCapacitySpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	spec := &ec2.TargetCapacitySpecificationRequest{
+		DefaultTargetCapacityType: aws.String(m["default_target_capacity_type"].(string)),
+		TotalTargetCapacity:       aws.Int64(int64(m["total_target_capacity"].(int))),
+	}
+
+	if v, ok := m["on_demand_target_capacity"].(int); ok && v != 0 {
+		spec.OnDemandTargetCapacity = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["spot_target_capacity"].(int); ok && v != 0 {
+		spec.SpotTargetCapacity = aws.Int64(int64(v))
+	}
+
+	return spec
+}
+
+func flattenEc2FleetLaunchTemplateConfigs(configs []*ec2.FleetLaunchTemplateConfig) []interface{} {
+	l := make([]interface{}, 0, len(configs))
+
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"launch_template_specification": flattenEc2FleetLaunchTemplateSpecification(config.LaunchTemplateSpecification),
+			"override":                      flattenEc2FleetLaunchTemplateOverrides(config.Overrides),
+		})
+	}
+
+	return l
+}
+
+func flattenEc2FleetLaunchTemplateSpecification(spec *ec2.FleetLaunchTemplateSpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"launch_template_id":   aws.StringValue(spec.LaunchTemplateId),
+		"launch_template_name": aws.StringValue(spec.LaunchTemplateName),
+		"version":              aws.StringValue(spec.Version),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEc2FleetLaunchTemplateOverrides(overrides []*ec2.FleetLaunchTemplateOverrides) []interface{} {
+	l := make([]interface{}, 0, len(overrides))
+
+	for _, override := range overrides {
+		if override == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"availability_zone": aws.StringValue(override.AvailabilityZone),
+			"instance_type":     aws.StringValue(override.InstanceType),
+			"max_price":         aws.StringValue(override.MaxPrice),
+			"subnet_id":         aws.StringValue(override.SubnetId),
+			"weighted_capacity": aws.Float64Value(override.WeightedCapacity),
+		})
+	}
+
+	return l
+}
+
+func flattenEc2SpotOptions(opts *ec2.SpotOptions) []interface{} {
+	if opts == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"allocation_strategy":            aws.StringValue(opts.AllocationStrategy),
+		"instance_interruption_behavior": aws.StringValue(opts.InstanceInterruptionBehavior),
+	}
+
+	// Fall back to the API defaults when they are not returned
+	if m["allocation_strategy"] == "" {
+		m["allocation_strategy"] = ec2.SpotAllocationStrategyLowestPrice
+	}
+
+	if m["instance_interruption_behavior"] == "" {
+		m["instance_interruption_behavior"] = ec2.SpotInstanceInterruptionBehaviorTerminate
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEc2TargetCapacitySpecification(spec *ec2.TargetCapacitySpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"default_target_capacity_type": aws.StringValue(spec.DefaultTargetCapacityType),
+		"on_demand_target_capacity":    int(aws.Int64Value(spec.OnDemandTargetCapacity)),
+		"spot_target_capacity":         int(aws.Int64Value(spec.SpotTargetCapacity)),
+		"total_target_capacity":        int(aws.Int64Value(spec.TotalTargetCapacity)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2Fleet_basic(t *testing.T) {
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2FleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2FleetConfig_TargetCapacitySpecification(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEc2FleetExists(resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "termination"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_id", "aws_launch_template.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.version", "aws_launch_template.test", "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "replace_unhealthy_instances", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.default_target_capacity_type", "spot"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instances", "true"),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instances_with_expiration", "false"),
+					resource.TestCheckResourceAttr(resourceName, "type", "maintain"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Fleet_TargetCapacitySpecification(t *testing.T) {
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2FleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2FleetConfig_TargetCapacitySpecification(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEc2FleetExists(resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2FleetConfig_TargetCapacitySpecification(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEc2FleetExists(resourceName, &fleet2),
+					testAccCheckAWSEc2FleetNotRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Fleet_OnDemandAndSpot(t *testing.T) {
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2FleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2FleetConfig_OnDemandAndSpot(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSEc2FleetExists(resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.1.instance_type", "t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", "diversified"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.instance_interruption_behavior", "terminate"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.default_target_capacity_type", "on-demand"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.on_demand_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.spot_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2FleetExists(resourceName string, fleet *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Fleet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := getEc2Fleet(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Fleet (%s) not found", rs.Primary.ID)
+		}
+
+		*fleet = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2FleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_fleet" {
+			continue
+		}
+
+		fleet, err := getEc2Fleet(conn, rs.Primary.ID)
+		if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if fleet == nil {
+			continue
+		}
+
+		if aws.StringValue(fleet.FleetState) != ec2.FleetStateCodeDeleted {
+			return fmt.Errorf("EC2 Fleet (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(fleet.FleetState))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2FleetNotRecreated(i, j *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreateTime).Equal(aws.TimeValue(j.CreateTime)) {
+			return fmt.Errorf("EC2 Fleet was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2FleetConfig_BaseLaunchTemplate(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "test" {
+  image_id      = "${data.aws_ami.test.id}"
+  instance_type = "t3.micro"
+  name          = %q
+}
+`, rName)
+}
+
+func testAccAWSEc2FleetConfig_TargetCapacitySpecification(rName string, totalTargetCapacity int) string {
+	return testAccAWSEc2FleetConfig_BaseLaunchTemplate(rName) + fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  terminate_instances = true
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = "${aws_launch_template.test.id}"
+      version            = "${aws_launch_template.test.latest_version}"
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = %d
+  }
+}
+`, totalTargetCapacity)
+}
+
+func testAccAWSEc2FleetConfig_OnDemandAndSpot(rName string) string {
+	return testAccAWSEc2FleetConfig_BaseLaunchTemplate(rName) + fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  terminate_instances = true
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = "${aws_launch_template.test.id}"
+      version            = "${aws_launch_template.test.latest_version}"
+    }
+
+    override {
+      instance_type = "t2.micro"
+    }
+
+    override {
+      instance_type = "t3.micro"
+    }
+  }
+
+  spot_options {
+    allocation_strategy = "diversified"
+  }
+
+  tags {
+    Name = %q
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    on_demand_target_capacity    = 1
+    spot_target_capacity         = 1
+    total_target_capacity        = 2
+  }
+}
+`, rName)
+}
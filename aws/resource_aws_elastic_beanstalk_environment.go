@@ -456,8 +456,8 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		oldTags := tagsFromMapBeanstalk(o.(map[string]interface{}))
 		newTags := tagsFromMapBeanstalk(n.(map[string]interface{}))
 
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsFmsAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsAdminAccountCreate,
+		Read:   resourceAwsFmsAdminAccountRead,
+		Delete: resourceAwsFmsAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsFmsAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &fms.AssociateAdminAccountInput{
+		AdminAccount: aws.String(accountID),
+	}
+
+	log.Printf("[DEBUG] Associating Firewall Manager administrator account: %s", input)
+	if _, err := conn.AssociateAdminAccount(input); err != nil {
+		return fmt.Errorf("error associating Firewall Manager administrator account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	// The association is not immediately visible to GetAdminAccount.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+		if err != nil {
+			if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if aws.StringValue(output.AdminAccount) != accountID {
+			return resource.RetryableError(fmt.Errorf("Firewall Manager administrator account is %q, expected %q", aws.StringValue(output.AdminAccount), accountID))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for Firewall Manager administrator account (%s) association: %s", accountID, err)
+	}
+
+	return resourceAwsFmsAdminAccountRead(d, meta)
+}
+
+func resourceAwsFmsAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+	if err != nil {
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Firewall Manager administrator account (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Firewall Manager administrator account (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != d.Id() {
+		log.Printf("[WARN] Firewall Manager administrator account (%s) has been replaced by %s, removing from state", d.Id(), aws.StringValue(output.AdminAccount))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", output.AdminAccount)
+
+	return nil
+}
+
+func resourceAwsFmsAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Disassociating Firewall Manager administrator account: %s", d.Id())
+	_, err := conn.DisassociateAdminAccount(&fms.DisassociateAdminAccountInput{})
+	if err != nil {
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error disassociating Firewall Manager administrator account (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
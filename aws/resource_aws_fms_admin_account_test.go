@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsFmsAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_fms_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsAdminAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsFmsAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_admin_account" {
+			continue
+		}
+
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+		if err != nil {
+			if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccount) == rs.Primary.ID {
+			return fmt.Errorf("Firewall Manager administrator account %q still associated", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsFmsAdminAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccount) != rs.Primary.ID {
+			return fmt.Errorf("Firewall Manager administrator account is %q, expected %q", aws.StringValue(output.AdminAccount), rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccFmsAdminAccountConfig = `
+resource "aws_fms_admin_account" "test" {}
+`
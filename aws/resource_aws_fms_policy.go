@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateJsonString,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fms.SecurityServiceTypeWaf,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: expandFmsPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating Firewall Manager policy: %s", input)
+	output, err := conn.PutPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating Firewall Manager policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Firewall Manager policy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Firewall Manager policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PolicyArn)
+	return flattenFmsPolicy(d, output.Policy)
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	policy := expandFmsPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	input := &fms.PutPolicyInput{
+		Policy: policy,
+	}
+
+	log.Printf("[DEBUG] Updating Firewall Manager policy: %s", input)
+	if _, err := conn.PutPolicy(input); err != nil {
+		return fmt.Errorf("error updating Firewall Manager policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Deleting Firewall Manager policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Firewall Manager policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFmsPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeResourceTags: aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		PolicyName:          aws.String(d.Get("name").(string)),
+		RemediationEnabled:  aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:        expandFmsResourceTags(d.Get("resource_tags").(map[string]interface{})),
+		ResourceType:        aws.String(d.Get("resource_type").(string)),
+	}
+
+	if v, ok := d.GetOk("security_service_policy_data"); ok && len(v.([]interface{})) > 0 {
+		m := v.([]interface{})[0].(map[string]interface{})
+		policy.SecurityServicePolicyData = &fms.SecurityServicePolicyData{
+			Type: aws.String(m["type"].(string)),
+		}
+		if s, ok := m["managed_service_data"].(string); ok && s != "" {
+			policy.SecurityServicePolicyData.ManagedServiceData = aws.String(s)
+		}
+	}
+
+	return policy
+}
+
+// flattenFmsPolicy sets the policy attributes shared by the resource and data source.
+func flattenFmsPolicy(d *schema.ResourceData, policy *fms.Policy) error {
+	d.Set("name", policy.PolicyName)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+	d.Set("resource_type", policy.ResourceType)
+
+	if err := d.Set("resource_tags", flattenFmsResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	return nil
+}
+
+func expandFmsResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]*fms.ResourceTag, 0, len(m))
+	for _, k := range keys {
+		tags = append(tags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(m[k].(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenFmsResourceTags(tags []*fms.ResourceTag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return m
+}
+
+func flattenFmsSecurityServicePolicyData(data *fms.SecurityServicePolicyData) []interface{} {
+	if data == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"managed_service_data": aws.StringValue(data.ManagedServiceData),
+		"type":                 aws.StringValue(data.Type),
+	}
+
+	return []interface{}{m}
+}
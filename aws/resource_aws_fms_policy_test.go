@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The Firewall Manager policy tests must be run from an account that is
+// already the Firewall Manager administrator account of its organization.
+func testAccAwsFmsPolicy_basic(t *testing.T) {
+	resourceName := "aws_fms_policy.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:fms:[^:]+:\d{12}:policy/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::ElasticLoadBalancingV2::LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "WAF"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_update_token"),
+				),
+			},
+			{
+				Config: testAccFmsPolicyConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsFmsPolicy_resourceTags(t *testing.T) {
+	resourceName := "aws_fms_policy.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfigResourceTags(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.Environment", "Testing"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.Usage", "original"),
+				),
+			},
+			{
+				Config: testAccFmsPolicyConfigResourceTags(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Firewall Manager policy %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsFmsPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccFmsPolicyConfigRuleGroup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfig(rName string, remediationEnabled bool) string {
+	return testAccFmsPolicyConfigRuleGroup(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  remediation_enabled   = %[2]t
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName, remediationEnabled)
+}
+
+func testAccFmsPolicyConfigResourceTags(rName string, excludeResourceTags bool) string {
+	return testAccFmsPolicyConfigRuleGroup(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = %[2]t
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  resource_tags {
+    Environment = "Testing"
+    Usage       = "original"
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName, excludeResourceTags)
+}
@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+)
+
+// Firewall Manager tests are serialized as an account can only have a single
+// administrator account, and policies can only be managed from it.
+func TestAccAWSFms(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AdminAccount": {
+			"basic": testAccAwsFmsAdminAccount_basic,
+		},
+		"Policy": {
+			"basic":          testAccAwsFmsPolicy_basic,
+			"resourceTags":   testAccAwsFmsPolicy_resourceTags,
+			"dataSourceName": testAccDataSourceAwsFmsPolicy_name,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
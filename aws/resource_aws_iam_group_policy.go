@@ -22,6 +22,8 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 		Read:   resourceAwsIamGroupPolicyRead,
 		Delete: resourceAwsIamGroupPolicyDelete,
 
+		CustomizeDiff: customizeDiffValidateIAMPolicy("policy", iamPolicyTypeIdentity, iamGroupInlinePolicyMaxSize),
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
 				Type:     schema.TypeString,
@@ -23,6 +23,8 @@ func resourceAwsIamPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffValidateIAMPolicy("policy", iamPolicyTypeIdentity, iamManagedPolicyMaxSize),
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
@@ -46,6 +46,20 @@ func TestAWSPolicy_invalidJson(t *testing.T) {
 	})
 }
 
+func TestAWSPolicy_invalidPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSPolicyInvalidEffectConfig,
+				ExpectError: regexp.MustCompile(`statement 1 \(Sid "DescribeAll"\): invalid Effect "Permit"`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSPolicyExists(resource string, res *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -129,3 +143,23 @@ resource "aws_iam_policy" "policy" {
   EOF
 }
 `
+
+const testAccAWSPolicyInvalidEffectConfig = `
+resource "aws_iam_policy" "policy" {
+  name_prefix = "test-policy-"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "DescribeAll",
+      "Action": "ec2:Describe*",
+      "Effect": "Permit",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`
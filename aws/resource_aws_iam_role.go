@@ -26,6 +26,8 @@ func resourceAwsIamRole() *schema.Resource {
 			State: resourceAwsIamRoleImport,
 		},
 
+		CustomizeDiff: customizeDiffValidateIAMPolicy("assume_role_policy", iamPolicyTypeTrust, iamAssumeRolePolicyMaxSize),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
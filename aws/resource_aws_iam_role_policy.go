@@ -25,6 +25,8 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffValidateIAMPolicy("policy", iamPolicyTypeIdentity, iamRoleInlinePolicyMaxSize),
+
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type:             schema.TypeString,
@@ -138,6 +138,22 @@ func TestAccAWSIAMRole_badJSON(t *testing.T) {
 	})
 }
 
+func TestAccAWSIAMRole_invalidAssumeRolePolicy(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMRoleConfig_invalidAssumeRolePolicy(rName),
+				ExpectError: regexp.MustCompile(`Resource and NotResource are not allowed in trust policies`),
+			},
+		},
+	})
+}
+
 func TestAccAWSIAMRole_force_detach_policies(t *testing.T) {
 	var conf iam.GetRoleOutput
 	rName := acctest.RandString(10)
@@ -479,6 +495,30 @@ POLICY
 `, rName)
 }
 
+func testAccAWSIAMRoleConfig_invalidAssumeRolePolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test-role-%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
 func testAccAWSIAMRoleConfig_force_detach_policies(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role_policy" "test" {
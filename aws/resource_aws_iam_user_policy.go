@@ -25,6 +25,8 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffValidateIAMPolicy("policy", iamPolicyTypeIdentity, iamUserInlinePolicyMaxSize),
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
 				Type:             schema.TypeString,
@@ -38,6 +38,8 @@ func resourceAwsInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiffCpuOptions,
+
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
@@ -280,6 +282,20 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"cpu_core_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"cpu_threads_per_core": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"tags": tagsSchema(),
 
 			"volume_tags": tagsSchemaComputed(),
@@ -505,6 +521,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		SubnetId:                          instanceOpts.SubnetID,
 		UserData:                          instanceOpts.UserData64,
 		CreditSpecification:               instanceOpts.CreditSpecification,
+		CpuOptions:                        instanceOpts.CpuOptions,
 	}
 
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
@@ -755,6 +772,12 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("ebs_optimized", instance.EbsOptimized)
+
+	if instance.CpuOptions != nil {
+		d.Set("cpu_core_count", instance.CpuOptions.CoreCount)
+		d.Set("cpu_threads_per_core", instance.CpuOptions.ThreadsPerCore)
+	}
+
 	if instance.SubnetId != nil && *instance.SubnetId != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -844,7 +867,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !d.IsNewResource() || restricted {
 			if err := setTags(conn, d); err != nil {
 				return err
@@ -1688,6 +1711,22 @@ type awsInstanceOpts struct {
 	SubnetID                          *string
 	UserData64                        *string
 	CreditSpecification               *ec2.CreditSpecificationRequest
+	CpuOptions                        *ec2.CpuOptionsRequest
+}
+
+// resourceAwsInstanceCustomizeDiffCpuOptions requires cpu_core_count and
+// cpu_threads_per_core to be set together, as EC2 rejects CPU options with
+// only one of them.
+func resourceAwsInstanceCustomizeDiffCpuOptions(diff *schema.ResourceDiff, meta interface{}) error {
+	// An attribute left out of the configuration is computed, and so unknown,
+	// at plan time, which GetOk reports as unset
+	_, coreCountOk := diff.GetOk("cpu_core_count")
+	_, threadsPerCoreOk := diff.GetOk("cpu_threads_per_core")
+	if coreCountOk != threadsPerCoreOk {
+		return fmt.Errorf("cpu_core_count and cpu_threads_per_core must be set together")
+	}
+
+	return nil
 }
 
 func buildAwsInstanceOpts(
@@ -1708,6 +1747,14 @@ func buildAwsInstanceOpts(
 		}
 	}
 
+	// EC2 requires both CPU options, see resourceAwsInstanceCustomizeDiffCpuOptions
+	if v, ok := d.GetOk("cpu_core_count"); ok {
+		opts.CpuOptions = &ec2.CpuOptionsRequest{
+			CoreCount:      aws.Int64(int64(v.(int))),
+			ThreadsPerCore: aws.Int64(int64(d.Get("cpu_threads_per_core").(int))),
+		}
+	}
+
 	if v := d.Get("instance_initiated_shutdown_behavior").(string); v != "" {
 		opts.InstanceInitiatedShutdownBehavior = aws.String(v)
 	}
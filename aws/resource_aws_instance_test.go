@@ -1519,6 +1519,62 @@ func TestAccAWSInstance_creditSpecification_unlimitedCpuCredits(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_cpuOptions(t *testing.T) {
+	var instance ec2.Instance
+	resName := "aws_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_cpuOptions(2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "cpu_core_count", "2"),
+					resource.TestCheckResourceAttr(resName, "cpu_threads_per_core", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_cpuOptions_partial(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_cpuOptions_threadsPerCoreOnly,
+				ExpectError: regexp.MustCompile(`cpu_core_count and cpu_threads_per_core must be set together`),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_cpuOptions_unspecified(t *testing.T) {
+	var instance ec2.Instance
+	resName := "aws_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_cpuOptions_unspecified,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "cpu_core_count", "2"),
+					resource.TestCheckResourceAttr(resName, "cpu_threads_per_core", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_creditSpecification_updateCpuCredits(t *testing.T) {
 	var before ec2.Instance
 	var after ec2.Instance
@@ -3153,6 +3209,49 @@ func testAccInstanceConfig_getPasswordData(val bool, rInt int) string {
 	`, rInt, val)
 }
 
+const testAccInstanceConfig_cpuOptions_base = `
+data "aws_ami" "amzn-ami-minimal-hvm" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+`
+
+func testAccInstanceConfig_cpuOptions(coreCount, threadsPerCore int) string {
+	return testAccInstanceConfig_cpuOptions_base + fmt.Sprintf(`
+resource "aws_instance" "foo" {
+  ami                  = "${data.aws_ami.amzn-ami-minimal-hvm.id}"
+  instance_type        = "c5.xlarge"
+  cpu_core_count       = %d
+  cpu_threads_per_core = %d
+}
+`, coreCount, threadsPerCore)
+}
+
+const testAccInstanceConfig_cpuOptions_unspecified = testAccInstanceConfig_cpuOptions_base + `
+resource "aws_instance" "foo" {
+  ami           = "${data.aws_ami.amzn-ami-minimal-hvm.id}"
+  instance_type = "c5.xlarge"
+}
+`
+
+const testAccInstanceConfig_cpuOptions_threadsPerCoreOnly = testAccInstanceConfig_cpuOptions_base + `
+resource "aws_instance" "foo" {
+  ami                  = "${data.aws_ami.amzn-ami-minimal-hvm.id}"
+  instance_type        = "c5.xlarge"
+  cpu_threads_per_core = 1
+}
+`
+
 func testAccInstanceConfig_creditSpecification_unspecified(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "my_vpc" {
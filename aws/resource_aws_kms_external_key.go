@@ -0,0 +1,408 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsExternalKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsExternalKeyCreate,
+		Read:   resourceAwsKmsExternalKeyRead,
+		Update: resourceAwsKmsExternalKeyUpdate,
+		Delete: resourceAwsKmsExternalKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"expiration_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_base64": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"tags": tagsSchema(),
+			"valid_to": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339TimeDiffs,
+			},
+		},
+
+		CustomizeDiff: resourceAwsKmsExternalKeyCustomizeDiff,
+	}
+}
+
+func resourceAwsKmsExternalKeyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// valid_to is only sent to AWS along with the key material
+	if v := diff.Get("valid_to").(string); v != "" && diff.NewValueKnown("key_material_base64") {
+		if diff.Get("key_material_base64").(string) == "" {
+			return fmt.Errorf("valid_to can only be set along with key_material_base64")
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	req := &kms.CreateKeyInput{
+		KeyUsage: aws.String(kms.KeyUsageTypeEncryptDecrypt),
+		Origin:   aws.String(kms.OriginTypeExternal),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("policy"); ok {
+		req.Policy = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	}
+
+	var resp *kms.CreateKeyOutput
+	// AWS requires any principal in the policy to exist before the key is created.
+	err := resource.Retry(30*time.Second, func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateKey(req)
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating KMS External Key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.KeyMetadata.KeyId))
+
+	if v, ok := d.GetOk("key_material_base64"); ok {
+		if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+			return fmt.Errorf("error importing KMS External Key (%s) material: %s", d.Id(), err)
+		}
+
+		// Imported key material leaves the key enabled
+		if v, ok := d.GetOkExists("enabled"); ok && !v.(bool) {
+			if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	req := &kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	}
+
+	var resp *kms.DescribeKeyOutput
+	var err error
+	if d.IsNewResource() {
+		var out interface{}
+		out, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKey(req)
+		})
+		resp, _ = out.(*kms.DescribeKeyOutput)
+	} else {
+		resp, err = conn.DescribeKey(req)
+	}
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] KMS External Key %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error describing KMS External Key (%s): %s", d.Id(), err)
+	}
+
+	metadata := resp.KeyMetadata
+
+	if aws.StringValue(metadata.KeyState) == kms.KeyStatePendingDeletion {
+		log.Printf("[WARN] KMS External Key %q is pending deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", metadata.Arn)
+	d.Set("description", metadata.Description)
+	d.Set("enabled", metadata.Enabled)
+	d.Set("expiration_model", metadata.ExpirationModel)
+	d.Set("key_state", metadata.KeyState)
+	d.Set("key_usage", metadata.KeyUsage)
+	if metadata.ValidTo != nil {
+		d.Set("valid_to", aws.TimeValue(metadata.ValidTo).Format(time.RFC3339))
+	} else {
+		d.Set("valid_to", "")
+	}
+
+	pOut, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
+			KeyId:      metadata.KeyId,
+			PolicyName: aws.String("default"),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error getting KMS External Key (%s) policy: %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(pOut.(*kms.GetKeyPolicyOutput).Policy))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+	d.Set("policy", policy)
+
+	tOut, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.ListResourceTags(&kms.ListResourceTagsInput{
+			KeyId: metadata.KeyId,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error listing KMS External Key (%s) tags: %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapKMS(tOut.(*kms.ListResourceTagsOutput).Tags))
+
+	return nil
+}
+
+func resourceAwsKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// Enable before any attributes will be modified
+	if d.HasChange("enabled") && d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		log.Printf("[DEBUG] Updating KMS External Key (%s) description", d.Id())
+		_, err := conn.UpdateKeyDescription(&kms.UpdateKeyDescriptionInput{
+			Description: aws.String(d.Get("description").(string)),
+			KeyId:       aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating KMS External Key (%s) description: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+
+		log.Printf("[DEBUG] Updating KMS External Key (%s) policy: %s", d.Id(), policy)
+		_, err = conn.PutKeyPolicy(&kms.PutKeyPolicyInput{
+			KeyId:      aws.String(d.Id()),
+			Policy:     aws.String(policy),
+			PolicyName: aws.String("default"),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating KMS External Key (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	// The expiration of imported key material can only be changed by importing it again
+	if d.HasChange("valid_to") {
+		if v, ok := d.GetOk("key_material_base64"); ok {
+			log.Printf("[DEBUG] Deleting KMS External Key (%s) material", d.Id())
+			_, err := conn.DeleteImportedKeyMaterial(&kms.DeleteImportedKeyMaterialInput{
+				KeyId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting KMS External Key (%s) material: %s", d.Id(), err)
+			}
+
+			if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+				return fmt.Errorf("error importing KMS External Key (%s) material: %s", d.Id(), err)
+			}
+
+			if !d.Get("enabled").(bool) {
+				if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Only disable when all attributes are modified because we cannot modify disabled keys
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		if err := setTagsKMS(conn, d, d.Id()); err != nil {
+			return fmt.Errorf("error updating KMS External Key (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	req := &kms.ScheduleKeyDeletionInput{
+		KeyId: aws.String(d.Id()),
+	}
+	if v, ok := d.GetOk("deletion_window_in_days"); ok {
+		req.PendingWindowInDays = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Scheduling KMS External Key (%s) deletion", d.Id())
+	if _, err := conn.ScheduleKeyDeletion(req); err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error scheduling KMS External Key (%s) deletion: %s", d.Id(), err)
+	}
+
+	// Wait for propagation since KMS is eventually consistent
+	wait := resource.StateChangeConf{
+		Pending:                   []string{kms.KeyStateEnabled, kms.KeyStateDisabled, kms.KeyStatePendingImport},
+		Target:                    []string{kms.KeyStatePendingDeletion},
+		Timeout:                   20 * time.Minute,
+		MinTimeout:                2 * time.Second,
+		ContinuousTargetOccurence: 10,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeKey(&kms.DescribeKeyInput{
+				KeyId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return resp, "Failed", err
+			}
+
+			return resp, aws.StringValue(resp.KeyMetadata.KeyState), nil
+		},
+	}
+
+	if _, err := wait.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for KMS External Key (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// importKmsExternalKeyMaterial wraps the key material with the public key returned
+// by KMS and imports it, optionally expiring at validTo.
+func importKmsExternalKeyMaterial(conn *kms.KMS, keyID, keyMaterialBase64, validTo string) error {
+	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
+	if err != nil {
+		return fmt.Errorf("error decoding key material: %s", err)
+	}
+
+	out, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+		return conn.GetParametersForImport(&kms.GetParametersForImportInput{
+			KeyId:             aws.String(keyID),
+			WrappingAlgorithm: aws.String(kms.AlgorithmSpecRsaesOaepSha256),
+			WrappingKeySpec:   aws.String(kms.WrappingKeySpecRsa2048),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error getting parameters for import: %s", err)
+	}
+	params := out.(*kms.GetParametersForImportOutput)
+
+	publicKey, err := x509.ParsePKIXPublicKey(params.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error parsing public key: %s", err)
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unexpected public key type %T", publicKey)
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPublicKey, keyMaterial, []byte{})
+	if err != nil {
+		return fmt.Errorf("error encrypting key material: %s", err)
+	}
+
+	input := &kms.ImportKeyMaterialInput{
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ExpirationModel:      aws.String(kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+		ImportToken:          params.ImportToken,
+		KeyId:                aws.String(keyID),
+	}
+
+	if validTo != "" {
+		t, err := time.Parse(time.RFC3339, validTo)
+		if err != nil {
+			return fmt.Errorf("error parsing valid_to: %s", err)
+		}
+		input.ExpirationModel = aws.String(kms.ExpirationModelTypeKeyMaterialExpires)
+		input.ValidTo = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Importing KMS External Key (%s) material", keyID)
+	if _, err := conn.ImportKeyMaterial(input); err != nil {
+		return err
+	}
+
+	return nil
+}
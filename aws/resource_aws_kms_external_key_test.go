@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// A fixed 256-bit key material, only suitable for testing
+const testAccAWSKmsExternalKeyMaterial = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+
+func TestAccAWSKmsExternalKey_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	resourceName := "aws_kms_external_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "PendingImport"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "ENCRYPT_DECRYPT"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_keyMaterial(t *testing.T) {
+	var key kms.KeyMetadata
+	resourceName := "aws_kms_external_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_DOES_NOT_EXPIRE"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "key_material_base64"},
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsExternalKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_EXPIRES"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", "2030-01-01T00:00:00Z"),
+				),
+			},
+			{
+				Config:   testAccAWSKmsExternalKeyConfigKeyMaterial(rName, "2030-01-01T01:00:00+01:00"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_validToWithoutKeyMaterial(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSKmsExternalKeyConfigValidToWithoutKeyMaterial(rName),
+				ExpectError: regexp.MustCompile(`valid_to can only be set along with key_material_base64`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsExternalKeyExists(name string, key *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS External Key ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+		out, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKey(&kms.DescribeKeyInput{
+				KeyId: aws.String(rs.Primary.ID),
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		*key = *out.(*kms.DescribeKeyOutput).KeyMetadata
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKmsExternalKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_external_key" {
+			continue
+		}
+
+		out, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(out.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+			continue
+		}
+
+		return fmt.Errorf("KMS External Key %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSKmsExternalKeyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = %q
+  deletion_window_in_days = 7
+}
+`, rName)
+}
+
+func testAccAWSKmsExternalKeyConfigKeyMaterial(rName, validTo string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = %q
+  deletion_window_in_days = 7
+  key_material_base64     = %q
+  valid_to                = %q
+}
+`, rName, testAccAWSKmsExternalKeyMaterial, validTo)
+}
+
+func testAccAWSKmsExternalKeyConfigValidToWithoutKeyMaterial(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = %q
+  deletion_window_in_days = 7
+  valid_to                = "2030-01-01T00:00:00Z"
+}
+`, rName)
+}
@@ -0,0 +1,396 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotCreate,
+		Read:   resourceAwsLexBotRead,
+		Update: resourceAwsLexBotUpdate,
+		Delete: resourceAwsLexBotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abort_statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"clarification_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"intent": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intent_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName,
+						},
+						"intent_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexVersion,
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lexmodelbuildingservice.LocaleEnUs,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.LocaleDeDe,
+					lexmodelbuildingservice.LocaleEnGb,
+					lexmodelbuildingservice.LocaleEnUs,
+				}, false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexBotName,
+			},
+			"process_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.ProcessBehaviorSave,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ProcessBehaviorBuild,
+					lexmodelbuildingservice.ProcessBehaviorSave,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexBot(d)
+
+	log.Printf("[DEBUG] Creating Lex bot: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutBot(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Lex bot (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex bot (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(d.Id()),
+		VersionOrAlias: aws.String(lexVersionLatest),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex bot (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Lex bot (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+
+	if err := flattenLexBot(d, output); err != nil {
+		return err
+	}
+
+	version, err := getLatestLexBotVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Lex bot (%s) versions: %s", d.Id(), err)
+	}
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexBot(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+
+	log.Printf("[DEBUG] Updating Lex bot: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutBot(input)
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+			return lexPreconditionFailedError("bot", d.Id(), err)
+		}
+		return fmt.Errorf("error updating Lex bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Lex bot (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	log.Printf("[DEBUG] Deleting Lex bot: %s", d.Id())
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBot(&lexmodelbuildingservice.DeleteBotInput{
+			Name: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Lex bot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForLexBotBuild waits for the $LATEST version of a bot to leave the
+// BUILDING status, failing if the build itself failed.
+func waitForLexBotBuild(conn *lexmodelbuildingservice.LexModelBuildingService, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelbuildingservice.StatusBuilding},
+		Target: []string{
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+		},
+		Refresh:    lexBotBuildRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func lexBotBuildRefreshFunc(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.Status)
+		if status == lexmodelbuildingservice.StatusFailed {
+			return output, status, fmt.Errorf("build failed: %s", aws.StringValue(output.FailureReason))
+		}
+
+		return output, status, nil
+	}
+}
+
+func expandLexBot(d *schema.ResourceData) *lexmodelbuildingservice.PutBotInput {
+	input := &lexmodelbuildingservice.PutBotInput{
+		AbortStatement:          expandLexStatement(d.Get("abort_statement").([]interface{})),
+		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
+		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt").([]interface{})),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		Intents:                 expandLexIntents(d.Get("intent").(*schema.Set).List()),
+		Locale:                  aws.String(d.Get("locale").(string)),
+		Name:                    aws.String(d.Get("name").(string)),
+		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_id"); ok {
+		input.VoiceId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// flattenLexBot sets the bot attributes shared by the resource and data source.
+func flattenLexBot(d *schema.ResourceData, output *lexmodelbuildingservice.GetBotOutput) error {
+	d.Set("checksum", output.Checksum)
+	d.Set("child_directed", output.ChildDirected)
+	d.Set("created_date", lexTimeToString(output.CreatedDate))
+	d.Set("description", output.Description)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", lexTimeToString(output.LastUpdatedDate))
+	d.Set("locale", output.Locale)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("voice_id", output.VoiceId)
+
+	if err := d.Set("abort_statement", flattenLexStatement(output.AbortStatement)); err != nil {
+		return fmt.Errorf("error setting abort_statement: %s", err)
+	}
+	if err := d.Set("clarification_prompt", flattenLexPrompt(output.ClarificationPrompt)); err != nil {
+		return fmt.Errorf("error setting clarification_prompt: %s", err)
+	}
+	if err := d.Set("intent", flattenLexIntents(output.Intents)); err != nil {
+		return fmt.Errorf("error setting intent: %s", err)
+	}
+
+	return nil
+}
+
+func getLatestLexBotVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	var versions []string
+
+	input := &lexmodelbuildingservice.GetBotVersionsInput{
+		Name: aws.String(name),
+	}
+	err := conn.GetBotVersionsPages(input, func(page *lexmodelbuildingservice.GetBotVersionsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			versions = append(versions, aws.StringValue(bot.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return lexLatestVersion(versions), nil
+}
+
+func expandLexIntents(rawValues []interface{}) []*lexmodelbuildingservice.Intent {
+	intents := make([]*lexmodelbuildingservice.Intent, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		intents = append(intents, &lexmodelbuildingservice.Intent{
+			IntentName:    aws.String(value["intent_name"].(string)),
+			IntentVersion: aws.String(value["intent_version"].(string)),
+		})
+	}
+
+	return intents
+}
+
+func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) []interface{} {
+	result := make([]interface{}, 0, len(intents))
+
+	for _, intent := range intents {
+		result = append(result, map[string]interface{}{
+			"intent_name":    aws.StringValue(intent.IntentName),
+			"intent_version": aws.StringValue(intent.IntentVersion),
+		})
+	}
+
+	return result
+}
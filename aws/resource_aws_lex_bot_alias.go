@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBotAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotAliasCreate,
+		Read:   resourceAwsLexBotAliasRead,
+		Update: resourceAwsLexBotAliasUpdate,
+		Delete: resourceAwsLexBotAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected BOT-NAME:ALIAS-NAME", d.Id())
+				}
+				d.Set("bot_name", idParts[0])
+				d.Set("name", idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexBotName,
+			},
+			"bot_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLexVersion,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName,
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	botName := d.Get("bot_name").(string)
+	name := d.Get("name").(string)
+
+	input := expandLexBotAlias(d)
+
+	log.Printf("[DEBUG] Creating Lex bot alias: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutBotAlias(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Lex bot alias (%s:%s): %s", botName, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", botName, name))
+
+	return resourceAwsLexBotAliasRead(d, meta)
+}
+
+func resourceAwsLexBotAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	botName := d.Get("bot_name").(string)
+
+	output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+		BotName: aws.String(botName),
+		Name:    aws.String(d.Get("name").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex bot alias (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Lex bot alias (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s:%s", botName, aws.StringValue(output.Name)),
+	}
+	d.Set("arn", arn.String())
+
+	flattenLexBotAlias(d, output)
+
+	return nil
+}
+
+func resourceAwsLexBotAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexBotAlias(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+
+	log.Printf("[DEBUG] Updating Lex bot alias: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutBotAlias(input)
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+			return lexPreconditionFailedError("bot alias", d.Id(), err)
+		}
+		return fmt.Errorf("error updating Lex bot alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotAliasRead(d, meta)
+}
+
+func resourceAwsLexBotAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	log.Printf("[DEBUG] Deleting Lex bot alias: %s", d.Id())
+	err := retryOnLexConflict(func() error {
+		_, err := conn.DeleteBotAlias(&lexmodelbuildingservice.DeleteBotAliasInput{
+			BotName: aws.String(d.Get("bot_name").(string)),
+			Name:    aws.String(d.Get("name").(string)),
+		})
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Lex bot alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexBotAlias(d *schema.ResourceData) *lexmodelbuildingservice.PutBotAliasInput {
+	input := &lexmodelbuildingservice.PutBotAliasInput{
+		BotName:    aws.String(d.Get("bot_name").(string)),
+		BotVersion: aws.String(d.Get("bot_version").(string)),
+		Name:       aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// flattenLexBotAlias sets the alias attributes shared by the resource and data source.
+func flattenLexBotAlias(d *schema.ResourceData, output *lexmodelbuildingservice.GetBotAliasOutput) {
+	d.Set("bot_name", output.BotName)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", lexTimeToString(output.CreatedDate))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", lexTimeToString(output.LastUpdatedDate))
+	d.Set("name", output.Name)
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBotAlias_basic(t *testing.T) {
+	resourceName := "aws_lex_bot_alias.test"
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotAliasConfig(rName, "Testing alias"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:lex:[^:]+:\d{12}:bot:.+:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bot_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "$LATEST"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing alias"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				Config: testAccAwsLexBotAliasConfig(rName, "Production alias"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Production alias"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotAliasExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex bot alias ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		_, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(rs.Primary.Attributes["bot_name"]),
+			Name:    aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsLexBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot_alias" {
+			continue
+		}
+
+		_, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(rs.Primary.Attributes["bot_name"]),
+			Name:    aws.String(rs.Primary.Attributes["name"]),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex bot alias %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexBotAliasConfig(rName, description string) string {
+	return testAccAwsLexBotConfig(rName, "SAVE", 300) + fmt.Sprintf(`
+resource "aws_lex_bot_alias" "test" {
+  bot_name    = "${aws_lex_bot.test.name}"
+  bot_version = "${aws_lex_bot.test.version}"
+  name        = "%s"
+  description = "%s"
+}
+`, rName, description)
+}
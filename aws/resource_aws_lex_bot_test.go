@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestLexLatestVersion(t *testing.T) {
+	testCases := []struct {
+		Versions []string
+		Expected string
+	}{
+		{
+			Versions: nil,
+			Expected: "$LATEST",
+		},
+		{
+			Versions: []string{"$LATEST"},
+			Expected: "$LATEST",
+		},
+		{
+			Versions: []string{"$LATEST", "1", "2"},
+			Expected: "2",
+		},
+		{
+			Versions: []string{"$LATEST", "10", "9"},
+			Expected: "10",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := lexLatestVersion(tc.Versions); got != tc.Expected {
+			t.Errorf("lexLatestVersion(%q) = %q, expected %q", tc.Versions, got, tc.Expected)
+		}
+	}
+}
+
+func TestValidateLexBotName(t *testing.T) {
+	validNames := []string{
+		"OrderFlowers",
+		"Order_Flowers",
+		"ab",
+	}
+	for _, v := range validNames {
+		if _, errors := validateLexBotName(v, "name"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lex bot name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"a",
+		"Order__Flowers",
+		"_OrderFlowers",
+		"OrderFlowers2",
+		"order-flowers",
+		"OrderFlowersOrderFlowersOrderFlowersOrderFlowersOrd",
+	}
+	for _, v := range invalidNames {
+		if _, errors := validateLexBotName(v, "name"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Lex bot name", v)
+		}
+	}
+}
+
+func TestAccAWSLexBot_basic(t *testing.T) {
+	resourceName := "aws_lex_bot.test"
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfig(rName, "SAVE", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:lex:[^:]+:\d{12}:bot:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "intent.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "status", "NOT_BUILT"),
+					resource.TestCheckResourceAttr(resourceName, "version", "$LATEST"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				Config: testAccAwsLexBotConfig(rName, "BUILD", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "status", "READY"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version", "process_behavior"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex bot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsLexBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot" {
+			continue
+		}
+
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex bot %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexBotConfig(rName, processBehavior string, idleSessionTTL int) string {
+	return testAccAwsLexIntentConfig(rName, "Order some flowers") + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  name                        = "%s"
+  description                 = "Bot to order flowers on the behalf of a user"
+  child_directed              = false
+  idle_session_ttl_in_seconds = %d
+  process_behavior            = "%s"
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName, idleSessionTTL, processBehavior)
+}
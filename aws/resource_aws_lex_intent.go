@@ -0,0 +1,490 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexIntentCreate,
+		Read:   resourceAwsLexIntentRead,
+		Update: resourceAwsLexIntentUpdate,
+		Delete: resourceAwsLexIntentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conclusion_statement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"follow_up_prompt"},
+				Elem:          lexStatementResource,
+			},
+			"confirmation_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexCodeHookResource,
+			},
+			"follow_up_prompt": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"conclusion_statement"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prompt": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     lexPromptResource,
+						},
+						"rejection_statement": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     lexStatementResource,
+						},
+					},
+				},
+			},
+			"fulfillment_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexCodeHookResource,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.FulfillmentActivityTypeCodeHook,
+								lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent,
+							}, false),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName,
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rejection_statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"response_card": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50000),
+						},
+						"sample_utterances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+						"slot_constraint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.SlotConstraintOptional,
+								lexmodelbuildingservice.SlotConstraintRequired,
+							}, false),
+						},
+						"slot_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"slot_type_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateLexVersion,
+						},
+						"value_elicitation_prompt": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexPromptResource,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexIntent(d)
+
+	log.Printf("[DEBUG] Creating Lex intent: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutIntent(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Lex intent (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex intent (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Lex intent (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("intent:%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+
+	if err := flattenLexIntent(d, output); err != nil {
+		return err
+	}
+
+	version, err := getLatestLexIntentVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Lex intent (%s) versions: %s", d.Id(), err)
+	}
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexIntent(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+
+	log.Printf("[DEBUG] Updating Lex intent: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutIntent(input)
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+			return lexPreconditionFailedError("intent", d.Id(), err)
+		}
+		return fmt.Errorf("error updating Lex intent (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	log.Printf("[DEBUG] Deleting Lex intent: %s", d.Id())
+	err := retryOnLexConflict(func() error {
+		_, err := conn.DeleteIntent(&lexmodelbuildingservice.DeleteIntentInput{
+			Name: aws.String(d.Id()),
+		})
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Lex intent (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexIntent(d *schema.ResourceData) *lexmodelbuildingservice.PutIntentInput {
+	input := &lexmodelbuildingservice.PutIntentInput{
+		ConclusionStatement: expandLexStatement(d.Get("conclusion_statement").([]interface{})),
+		ConfirmationPrompt:  expandLexPrompt(d.Get("confirmation_prompt").([]interface{})),
+		CreateVersion:       aws.Bool(d.Get("create_version").(bool)),
+		DialogCodeHook:      expandLexCodeHook(d.Get("dialog_code_hook").([]interface{})),
+		FollowUpPrompt:      expandLexFollowUpPrompt(d.Get("follow_up_prompt").([]interface{})),
+		FulfillmentActivity: expandLexFulfillmentActivity(d.Get("fulfillment_activity").([]interface{})),
+		Name:                aws.String(d.Get("name").(string)),
+		RejectionStatement:  expandLexStatement(d.Get("rejection_statement").([]interface{})),
+		SampleUtterances:    expandStringList(d.Get("sample_utterances").(*schema.Set).List()),
+		Slots:               expandLexSlots(d.Get("slot").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// flattenLexIntent sets the intent attributes shared by the resource and data source.
+func flattenLexIntent(d *schema.ResourceData, output *lexmodelbuildingservice.GetIntentOutput) error {
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", lexTimeToString(output.CreatedDate))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", lexTimeToString(output.LastUpdatedDate))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+
+	if err := d.Set("conclusion_statement", flattenLexStatement(output.ConclusionStatement)); err != nil {
+		return fmt.Errorf("error setting conclusion_statement: %s", err)
+	}
+	if err := d.Set("confirmation_prompt", flattenLexPrompt(output.ConfirmationPrompt)); err != nil {
+		return fmt.Errorf("error setting confirmation_prompt: %s", err)
+	}
+	if err := d.Set("dialog_code_hook", flattenLexCodeHook(output.DialogCodeHook)); err != nil {
+		return fmt.Errorf("error setting dialog_code_hook: %s", err)
+	}
+	if err := d.Set("follow_up_prompt", flattenLexFollowUpPrompt(output.FollowUpPrompt)); err != nil {
+		return fmt.Errorf("error setting follow_up_prompt: %s", err)
+	}
+	if err := d.Set("fulfillment_activity", flattenLexFulfillmentActivity(output.FulfillmentActivity)); err != nil {
+		return fmt.Errorf("error setting fulfillment_activity: %s", err)
+	}
+	if err := d.Set("rejection_statement", flattenLexStatement(output.RejectionStatement)); err != nil {
+		return fmt.Errorf("error setting rejection_statement: %s", err)
+	}
+	if err := d.Set("sample_utterances", flattenStringList(output.SampleUtterances)); err != nil {
+		return fmt.Errorf("error setting sample_utterances: %s", err)
+	}
+	if err := d.Set("slot", flattenLexSlots(output.Slots)); err != nil {
+		return fmt.Errorf("error setting slot: %s", err)
+	}
+
+	return nil
+}
+
+func getLatestLexIntentVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	var versions []string
+
+	input := &lexmodelbuildingservice.GetIntentVersionsInput{
+		Name: aws.String(name),
+	}
+	err := conn.GetIntentVersionsPages(input, func(page *lexmodelbuildingservice.GetIntentVersionsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			versions = append(versions, aws.StringValue(intent.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return lexLatestVersion(versions), nil
+}
+
+func expandLexFollowUpPrompt(rawValues []interface{}) *lexmodelbuildingservice.FollowUpPrompt {
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return nil
+	}
+	value := rawValues[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FollowUpPrompt{
+		Prompt:             expandLexPrompt(value["prompt"].([]interface{})),
+		RejectionStatement: expandLexStatement(value["rejection_statement"].([]interface{})),
+	}
+}
+
+func flattenLexFollowUpPrompt(prompt *lexmodelbuildingservice.FollowUpPrompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prompt":              flattenLexPrompt(prompt.Prompt),
+			"rejection_statement": flattenLexStatement(prompt.RejectionStatement),
+		},
+	}
+}
+
+func expandLexFulfillmentActivity(rawValues []interface{}) *lexmodelbuildingservice.FulfillmentActivity {
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return nil
+	}
+	value := rawValues[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FulfillmentActivity{
+		CodeHook: expandLexCodeHook(value["code_hook"].([]interface{})),
+		Type:     aws.String(value["type"].(string)),
+	}
+}
+
+func flattenLexFulfillmentActivity(activity *lexmodelbuildingservice.FulfillmentActivity) []interface{} {
+	if activity == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code_hook": flattenLexCodeHook(activity.CodeHook),
+			"type":      aws.StringValue(activity.Type),
+		},
+	}
+}
+
+func expandLexSlots(rawValues []interface{}) []*lexmodelbuildingservice.Slot {
+	slots := make([]*lexmodelbuildingservice.Slot, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		slot := &lexmodelbuildingservice.Slot{
+			Name:                   aws.String(value["name"].(string)),
+			Priority:               aws.Int64(int64(value["priority"].(int))),
+			SampleUtterances:       expandStringList(value["sample_utterances"].([]interface{})),
+			SlotConstraint:         aws.String(value["slot_constraint"].(string)),
+			SlotType:               aws.String(value["slot_type"].(string)),
+			ValueElicitationPrompt: expandLexPrompt(value["value_elicitation_prompt"].([]interface{})),
+		}
+
+		if v, ok := value["description"].(string); ok && v != "" {
+			slot.Description = aws.String(v)
+		}
+		if v, ok := value["response_card"].(string); ok && v != "" {
+			slot.ResponseCard = aws.String(v)
+		}
+		if v, ok := value["slot_type_version"].(string); ok && v != "" {
+			slot.SlotTypeVersion = aws.String(v)
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return slots
+}
+
+func flattenLexSlots(slots []*lexmodelbuildingservice.Slot) []interface{} {
+	result := make([]interface{}, 0, len(slots))
+
+	for _, slot := range slots {
+		result = append(result, map[string]interface{}{
+			"description":              aws.StringValue(slot.Description),
+			"name":                     aws.StringValue(slot.Name),
+			"priority":                 int(aws.Int64Value(slot.Priority)),
+			"response_card":            aws.StringValue(slot.ResponseCard),
+			"sample_utterances":        flattenStringList(slot.SampleUtterances),
+			"slot_constraint":          aws.StringValue(slot.SlotConstraint),
+			"slot_type":                aws.StringValue(slot.SlotType),
+			"slot_type_version":        aws.StringValue(slot.SlotTypeVersion),
+			"value_elicitation_prompt": flattenLexPrompt(slot.ValueElicitationPrompt),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexIntent_basic(t *testing.T) {
+	resourceName := "aws_lex_intent.test"
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfig(rName, "Order some flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:lex:[^:]+:\d{12}:intent:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Order some flowers"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.0.type", "ReturnIntent"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.0.max_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "$LATEST"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				Config: testAccAwsLexIntentConfig(rName, "Order flowers for delivery"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Order flowers for delivery"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexIntentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex intent ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsLexIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_intent" {
+			continue
+		}
+
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex intent %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexIntentConfig(rName, description string) string {
+	return testAccAwsLexSlotTypeConfig(rName, "Types of flowers to pick up") + fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name        = "%s"
+  description = "%s"
+
+  sample_utterances = [
+    "I would like to order some flowers",
+    "I would like to pick up flowers",
+  ]
+
+  confirmation_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "Okay, your {FlowerType} will be ready for pickup. Does this sound okay?"
+      content_type = "PlainText"
+    }
+  }
+
+  rejection_statement {
+    message {
+      content      = "Okay, I will not place your order."
+      content_type = "PlainText"
+    }
+  }
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  slot {
+    name            = "FlowerType"
+    description     = "The type of flowers to pick up"
+    priority        = 1
+    slot_constraint = "Required"
+    slot_type       = "${aws_lex_slot_type.test.name}"
+
+    sample_utterances = ["I would like to order {FlowerType}"]
+
+    value_elicitation_prompt {
+      max_attempts = 2
+
+      message {
+        content      = "What type of flowers would you like to order?"
+        content_type = "PlainText"
+      }
+    }
+  }
+}
+`, rName, description)
+}
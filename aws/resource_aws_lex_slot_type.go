@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexSlotTypeCreate,
+		Read:   resourceAwsLexSlotTypeRead,
+		Update: resourceAwsLexSlotTypeUpdate,
+		Delete: resourceAwsLexSlotTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synonyms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 140),
+							},
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName,
+			},
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+					lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution,
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexSlotType(d)
+
+	log.Printf("[DEBUG] Creating Lex slot type: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutSlotType(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Lex slot type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lex slot type (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Lex slot type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", lexTimeToString(output.CreatedDate))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", lexTimeToString(output.LastUpdatedDate))
+	d.Set("name", output.Name)
+	d.Set("value_selection_strategy", output.ValueSelectionStrategy)
+
+	if err := d.Set("enumeration_value", flattenLexEnumerationValues(output.EnumerationValues)); err != nil {
+		return fmt.Errorf("error setting enumeration_value: %s", err)
+	}
+
+	version, err := getLatestLexSlotTypeVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Lex slot type (%s) versions: %s", d.Id(), err)
+	}
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexSlotType(d)
+	// The checksum of the revision last read makes the update fail rather
+	// than overwrite changes made since.
+	input.Checksum = aws.String(d.Get("checksum").(string))
+
+	log.Printf("[DEBUG] Updating Lex slot type: %s", input)
+	err := retryOnLexConflict(func() error {
+		_, err := conn.PutSlotType(input)
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodePreconditionFailedException, "") {
+			return lexPreconditionFailedError("slot type", d.Id(), err)
+		}
+		return fmt.Errorf("error updating Lex slot type (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	log.Printf("[DEBUG] Deleting Lex slot type: %s", d.Id())
+	err := retryOnLexConflict(func() error {
+		_, err := conn.DeleteSlotType(&lexmodelbuildingservice.DeleteSlotTypeInput{
+			Name: aws.String(d.Id()),
+		})
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Lex slot type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexSlotType(d *schema.ResourceData) *lexmodelbuildingservice.PutSlotTypeInput {
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		CreateVersion:          aws.Bool(d.Get("create_version").(bool)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(d.Get("name").(string)),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func getLatestLexSlotTypeVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	var versions []string
+
+	input := &lexmodelbuildingservice.GetSlotTypeVersionsInput{
+		Name: aws.String(name),
+	}
+	err := conn.GetSlotTypeVersionsPages(input, func(page *lexmodelbuildingservice.GetSlotTypeVersionsOutput, lastPage bool) bool {
+		for _, slotType := range page.SlotTypes {
+			versions = append(versions, aws.StringValue(slotType.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return lexLatestVersion(versions), nil
+}
+
+func expandLexEnumerationValues(rawValues []interface{}) []*lexmodelbuildingservice.EnumerationValue {
+	values := make([]*lexmodelbuildingservice.EnumerationValue, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value := rawValue.(map[string]interface{})
+
+		values = append(values, &lexmodelbuildingservice.EnumerationValue{
+			Synonyms: expandStringList(value["synonyms"].(*schema.Set).List()),
+			Value:    aws.String(value["value"].(string)),
+		})
+	}
+
+	return values
+}
+
+func flattenLexEnumerationValues(values []*lexmodelbuildingservice.EnumerationValue) []interface{} {
+	result := make([]interface{}, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, map[string]interface{}{
+			"synonyms": flattenStringList(value.Synonyms),
+			"value":    aws.StringValue(value.Value),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexSlotType_basic(t *testing.T) {
+	resourceName := "aws_lex_slot_type.test"
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "Types of flowers to pick up"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Types of flowers to pick up"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", "ORIGINAL_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "version", "$LATEST"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+				),
+			},
+			{
+				Config: testAccAwsLexSlotTypeConfig(rName, "Kinds of flowers to pick up"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Kinds of flowers to pick up"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_createVersion(t *testing.T) {
+	resourceName := "aws_lex_slot_type.test"
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfigCreateVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexSlotTypeExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex slot type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsLexSlotTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_slot_type" {
+			continue
+		}
+
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Lex slot type %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexSlotTypeConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name        = "%s"
+  description = "%s"
+
+  enumeration_value {
+    value    = "tulips"
+    synonyms = ["tulip"]
+  }
+
+  enumeration_value {
+    value    = "roses"
+    synonyms = ["rose", "red flower"]
+  }
+}
+`, rName, description)
+}
+
+func testAccAwsLexSlotTypeConfigCreateVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name           = "%s"
+  create_version = true
+
+  enumeration_value {
+    value = "tulips"
+  }
+}
+`, rName)
+}
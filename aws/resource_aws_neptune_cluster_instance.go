@@ -0,0 +1,527 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsNeptuneClusterInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNeptuneClusterInstanceCreate,
+		Read:   resourceAwsNeptuneClusterInstanceRead,
+		Update: resourceAwsNeptuneClusterInstanceUpdate,
+		Delete: resourceAwsNeptuneClusterInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// apply_immediately is used to determine when the update modifications
+			// take place.
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"dbi_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "neptune",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"neptune"}, false),
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"identifier_prefix"},
+				ValidateFunc:  validateRdsIdentifier,
+			},
+			"identifier_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifierPrefix,
+			},
+
+			"instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"neptune_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "default.neptune1",
+			},
+
+			"neptune_subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8182,
+				ForceNew: true,
+			},
+
+			"preferred_backup_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceADayWindowFormat,
+			},
+
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(v interface{}) string {
+					if v != nil {
+						value := v.(string)
+						return strings.ToLower(value)
+					}
+					return ""
+				},
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+
+			"promotion_tier": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"writer": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsNeptuneClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+	tags := tagsFromMapNeptune(d.Get("tags").(map[string]interface{}))
+
+	createOpts := &neptune.CreateDBInstanceInput{
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		DBClusterIdentifier:     aws.String(d.Get("cluster_identifier").(string)),
+		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
+		DBParameterGroupName:    aws.String(d.Get("neptune_parameter_group_name").(string)),
+		Engine:                  aws.String(d.Get("engine").(string)),
+		PromotionTier:           aws.Int64(int64(d.Get("promotion_tier").(int))),
+		PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
+		Tags:                    tags,
+	}
+
+	if v, ok := d.GetOk("identifier"); ok {
+		createOpts.DBInstanceIdentifier = aws.String(v.(string))
+	} else if v, ok := d.GetOk("identifier_prefix"); ok {
+		createOpts.DBInstanceIdentifier = aws.String(resource.PrefixedUniqueId(v.(string)))
+	} else {
+		createOpts.DBInstanceIdentifier = aws.String(resource.PrefixedUniqueId("tf-"))
+	}
+
+	if attr, ok := d.GetOk("availability_zone"); ok {
+		createOpts.AvailabilityZone = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("engine_version"); ok {
+		createOpts.EngineVersion = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("neptune_subnet_group_name"); ok {
+		createOpts.DBSubnetGroupName = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("preferred_backup_window"); ok {
+		createOpts.PreferredBackupWindow = aws.String(attr.(string))
+	}
+
+	if attr, ok := d.GetOk("preferred_maintenance_window"); ok {
+		createOpts.PreferredMaintenanceWindow = aws.String(attr.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Neptune Cluster Instance: %s", createOpts)
+	var resp *neptune.CreateDBInstanceOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateDBInstance(createOpts)
+		if err != nil {
+			if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Neptune Cluster Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.DBInstance.DBInstanceIdentifier))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsNeptuneClusterInstanceCreateUpdatePendingStates,
+		Target:     []string{"available"},
+		Refresh:    resourceAwsNeptuneInstanceStateRefreshFunc(d.Id(), conn),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for Neptune Cluster Instance (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsNeptuneClusterInstanceRead(d, meta)
+}
+
+func resourceAwsNeptuneClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	db, err := resourceAwsNeptuneInstanceRetrieve(d.Id(), conn)
+	// Errors from this helper are always reportable
+	if err != nil {
+		return fmt.Errorf("error reading Neptune Cluster Instance (%s): %s", d.Id(), err)
+	}
+	// A nil response means "not found"
+	if db == nil {
+		log.Printf("[WARN] Neptune Cluster Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if db.DBClusterIdentifier == nil {
+		return fmt.Errorf("Cluster identifier is missing from instance (%s)", d.Id())
+	}
+
+	// Retrieve Neptune Cluster information, to determine if this Instance is a writer
+	resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
+		DBClusterIdentifier: db.DBClusterIdentifier,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Neptune Cluster (%s) of Cluster Instance (%s): %s", aws.StringValue(db.DBClusterIdentifier), d.Id(), err)
+	}
+
+	var dbc *neptune.DBCluster
+	for _, c := range resp.DBClusters {
+		if aws.StringValue(c.DBClusterIdentifier) == aws.StringValue(db.DBClusterIdentifier) {
+			dbc = c
+		}
+	}
+	if dbc == nil {
+		return fmt.Errorf("Neptune Cluster (%s) of Cluster Instance (%s) not found", aws.StringValue(db.DBClusterIdentifier), d.Id())
+	}
+
+	for _, m := range dbc.DBClusterMembers {
+		if aws.StringValue(db.DBInstanceIdentifier) == aws.StringValue(m.DBInstanceIdentifier) {
+			d.Set("writer", aws.BoolValue(m.IsClusterWriter))
+		}
+	}
+
+	if db.Endpoint != nil {
+		address := aws.StringValue(db.Endpoint.Address)
+		port := int(aws.Int64Value(db.Endpoint.Port))
+		d.Set("address", address)
+		d.Set("endpoint", fmt.Sprintf("%s:%d", address, port))
+		d.Set("port", port)
+	}
+
+	if db.DBSubnetGroup != nil {
+		d.Set("neptune_subnet_group_name", db.DBSubnetGroup.DBSubnetGroupName)
+	}
+
+	if len(db.DBParameterGroups) > 0 {
+		d.Set("neptune_parameter_group_name", db.DBParameterGroups[0].DBParameterGroupName)
+	}
+
+	d.Set("arn", db.DBInstanceArn)
+	d.Set("auto_minor_version_upgrade", db.AutoMinorVersionUpgrade)
+	d.Set("availability_zone", db.AvailabilityZone)
+	d.Set("cluster_identifier", db.DBClusterIdentifier)
+	d.Set("dbi_resource_id", db.DbiResourceId)
+	d.Set("engine", db.Engine)
+	d.Set("engine_version", db.EngineVersion)
+	d.Set("identifier", db.DBInstanceIdentifier)
+	d.Set("instance_class", db.DBInstanceClass)
+	d.Set("kms_key_arn", db.KmsKeyId)
+	d.Set("preferred_backup_window", db.PreferredBackupWindow)
+	d.Set("preferred_maintenance_window", db.PreferredMaintenanceWindow)
+	d.Set("promotion_tier", db.PromotionTier)
+	d.Set("publicly_accessible", db.PubliclyAccessible)
+	d.Set("storage_encrypted", db.StorageEncrypted)
+
+	tagsResp, err := conn.ListTagsForResource(&neptune.ListTagsForResourceInput{
+		ResourceName: db.DBInstanceArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Neptune Cluster Instance (%s): %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapNeptune(tagsResp.TagList))
+
+	return nil
+}
+
+func resourceAwsNeptuneClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+	requestUpdate := false
+
+	req := &neptune.ModifyDBInstanceInput{
+		ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
+		DBInstanceIdentifier: aws.String(d.Id()),
+	}
+
+	if d.HasChange("neptune_parameter_group_name") {
+		req.DBParameterGroupName = aws.String(d.Get("neptune_parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("instance_class") {
+		req.DBInstanceClass = aws.String(d.Get("instance_class").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		d.SetPartial("preferred_backup_window")
+		req.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		d.SetPartial("preferred_maintenance_window")
+		req.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("auto_minor_version_upgrade") {
+		d.SetPartial("auto_minor_version_upgrade")
+		req.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("promotion_tier") {
+		d.SetPartial("promotion_tier")
+		req.PromotionTier = aws.Int64(int64(d.Get("promotion_tier").(int)))
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Neptune Cluster Instance modification request: %s", req)
+		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+			_, err := conn.ModifyDBInstance(req)
+			if err != nil {
+				if isAWSErr(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying Neptune Cluster Instance (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    resourceAwsNeptuneClusterInstanceCreateUpdatePendingStates,
+			Target:     []string{"available"},
+			Refresh:    resourceAwsNeptuneInstanceStateRefreshFunc(d.Id(), conn),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 10 * time.Second,
+			Delay:      30 * time.Second, // Wait 30 secs before starting
+		}
+
+		// Wait, catching any errors
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("error waiting for Neptune Cluster Instance (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsNeptune(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsNeptuneClusterInstanceRead(d, meta)
+}
+
+func resourceAwsNeptuneClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	log.Printf("[DEBUG] Deleting Neptune Cluster Instance: %s", d.Id())
+	_, err := conn.DeleteDBInstance(&neptune.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, neptune.ErrCodeDBInstanceNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Neptune Cluster Instance (%s): %s", d.Id(), err)
+	}
+
+	log.Println("[INFO] Waiting for Neptune Cluster Instance to be destroyed")
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsNeptuneClusterInstanceDeletePendingStates,
+		Target:     []string{},
+		Refresh:    resourceAwsNeptuneInstanceStateRefreshFunc(d.Id(), conn),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Neptune Cluster Instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsNeptuneInstanceRetrieve returns the Neptune instance with the
+// given identifier, or nil if it does not exist.
+func resourceAwsNeptuneInstanceRetrieve(id string, conn *neptune.Neptune) (*neptune.DBInstance, error) {
+	resp, err := conn.DescribeDBInstances(&neptune.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(id),
+	})
+	if err != nil {
+		if isAWSErr(err, neptune.ErrCodeDBInstanceNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, db := range resp.DBInstances {
+		if aws.StringValue(db.DBInstanceIdentifier) == id {
+			return db, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceAwsNeptuneInstanceStateRefreshFunc(id string, conn *neptune.Neptune) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := resourceAwsNeptuneInstanceRetrieve(id, conn)
+		if err != nil {
+			log.Printf("[WARN] Error on retrieving Neptune Cluster Instance (%s) when waiting: %s", id, err)
+			return nil, "", err
+		}
+
+		if v == nil {
+			return nil, "", nil
+		}
+
+		if v.DBInstanceStatus != nil {
+			log.Printf("[DEBUG] Neptune Cluster Instance status (%s): %s", id, *v.DBInstanceStatus)
+		}
+
+		return v, aws.StringValue(v.DBInstanceStatus), nil
+	}
+}
+
+var resourceAwsNeptuneClusterInstanceCreateUpdatePendingStates = []string{
+	"backing-up",
+	"configuring-log-exports",
+	"creating",
+	"maintenance",
+	"modifying",
+	"rebooting",
+	"renaming",
+	"resetting-master-credentials",
+	"starting",
+	"upgrading",
+}
+
+var resourceAwsNeptuneClusterInstanceDeletePendingStates = []string{
+	"modifying",
+	"deleting",
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNeptuneClusterInstance_basic(t *testing.T) {
+	var v neptune.DBInstance
+	resourceName := "aws_neptune_cluster_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterInstanceConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterInstanceExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:rds:[^:]+:\d{12}:db:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_neptune_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r4.large"),
+					resource.TestCheckResourceAttr(resourceName, "neptune_parameter_group_name", "default.neptune1"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8182"),
+					resource.TestCheckResourceAttr(resourceName, "promotion_tier", "0"),
+					resource.TestCheckResourceAttr(resourceName, "writer", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "address"),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrSet(resourceName, "dbi_resource_id"),
+					resource.TestMatchResourceAttr(resourceName, "endpoint", regexp.MustCompile(`:8182$`)),
+				),
+			},
+			{
+				Config: testAccAWSNeptuneClusterInstanceConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "promotion_tier", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNeptuneClusterInstanceExists(n string, v *neptune.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Neptune Cluster Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		db, err := resourceAwsNeptuneInstanceRetrieve(rs.Primary.ID, conn)
+		if err != nil {
+			return err
+		}
+		if db == nil {
+			return fmt.Errorf("Neptune Cluster Instance (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *db
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNeptuneClusterInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_cluster_instance" {
+			continue
+		}
+
+		db, err := resourceAwsNeptuneInstanceRetrieve(rs.Primary.ID, conn)
+		if err != nil {
+			return err
+		}
+		if db != nil {
+			return fmt.Errorf("Neptune Cluster Instance %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNeptuneClusterInstanceConfig(rName string, promotionTier int) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = "%[1]s"
+  skip_final_snapshot = true
+}
+
+resource "aws_neptune_cluster_instance" "test" {
+  identifier         = "%[1]s"
+  cluster_identifier = "${aws_neptune_cluster.test.id}"
+  instance_class     = "db.r4.large"
+  promotion_tier     = %[2]d
+  apply_immediately  = true
+}
+`, rName, promotionTier)
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNeptuneClusterSnapshot_basic(t *testing.T) {
+	var dbClusterSnapshot neptune.DBClusterSnapshot
+	resourceName := "aws_neptune_cluster_snapshot.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterSnapshotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterSnapshotExists(resourceName, &dbClusterSnapshot),
+					resource.TestCheckResourceAttrSet(resourceName, "allocated_storage"),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zones.#"),
+					resource.TestMatchResourceAttr(resourceName, "db_cluster_snapshot_arn", regexp.MustCompile(`^arn:[^:]+:rds:[^:]+:\d{12}:cluster-snapshot:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "db_cluster_snapshot_identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_identifier", "aws_neptune_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8182"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_type", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNeptuneClusterSnapshotExists(n string, v *neptune.DBClusterSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Neptune Cluster Snapshot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		resp, err := conn.DescribeDBClusterSnapshots(&neptune.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.DBClusterSnapshots) == 0 {
+			return fmt.Errorf("Neptune Cluster Snapshot (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.DBClusterSnapshots[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNeptuneClusterSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_cluster_snapshot" {
+			continue
+		}
+
+		resp, err := conn.DescribeDBClusterSnapshots(&neptune.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, neptune.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.DBClusterSnapshots) != 0 {
+			return fmt.Errorf("Neptune Cluster Snapshot %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSNeptuneClusterSnapshotConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = "%[1]s"
+  skip_final_snapshot = true
+}
+
+resource "aws_neptune_cluster_snapshot" "test" {
+  db_cluster_identifier          = "${aws_neptune_cluster.test.id}"
+  db_cluster_snapshot_identifier = "%[1]s"
+}
+`, rName)
+}
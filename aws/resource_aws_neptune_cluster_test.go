@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSNeptuneCluster_basic(t *testing.T) {
+	var dbCluster neptune.DBCluster
+	resourceName := "aws_neptune_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:rds:[^:]+:\d{12}:cluster:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8182"),
+					resource.TestCheckResourceAttr(resourceName, "neptune_cluster_parameter_group_name", "default.neptune1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "reader_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "hosted_zone_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneCluster_namePrefix(t *testing.T) {
+	var dbCluster neptune.DBCluster
+	resourceName := "aws_neptune_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterConfigNamePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestMatchResourceAttr(resourceName, "cluster_identifier", regexp.MustCompile("^tf-test-")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneCluster_updates(t *testing.T) {
+	var dbCluster neptune.DBCluster
+	resourceName := "aws_neptune_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterConfigUpdates(rName, false, 5, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "iam_database_authentication_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "5"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "07:00-09:00"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "foo"),
+				),
+			},
+			{
+				Config: testAccAWSNeptuneClusterConfigUpdates(rName, true, 10, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "iam_database_authentication_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "10"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneCluster_kmsKey(t *testing.T) {
+	var dbCluster neptune.DBCluster
+	resourceName := "aws_neptune_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterConfigKmsKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneCluster_finalSnapshot(t *testing.T) {
+	var dbCluster neptune.DBCluster
+	resourceName := "aws_neptune_cluster.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterSnapshot(rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterConfigFinalSnapshot(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNeptuneClusterExists(n string, v *neptune.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Neptune Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range resp.DBClusters {
+			if aws.StringValue(c.DBClusterIdentifier) == rs.Primary.ID {
+				*v = *c
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Neptune Cluster (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSNeptuneClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_cluster" {
+			continue
+		}
+
+		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, neptune.ErrCodeDBClusterNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+
+		for _, c := range resp.DBClusters {
+			if aws.StringValue(c.DBClusterIdentifier) == rs.Primary.ID {
+				return fmt.Errorf("Neptune Cluster %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckAWSNeptuneClusterSnapshot checks that the cluster is gone and
+// that its final snapshot was taken, then deletes the snapshot.
+func testAccCheckAWSNeptuneClusterSnapshot(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := testAccCheckAWSNeptuneClusterDestroy(s); err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		snapshotIdentifier := fmt.Sprintf("%s-final", rName)
+
+		_, err := conn.DescribeDBClusterSnapshots(&neptune.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(snapshotIdentifier),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading final snapshot (%s): %s", snapshotIdentifier, err)
+		}
+
+		_, err = conn.DeleteDBClusterSnapshot(&neptune.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(snapshotIdentifier),
+		})
+		return err
+	}
+}
+
+func testAccAWSNeptuneClusterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = "%s"
+  skip_final_snapshot = true
+}
+`, rName)
+}
+
+const testAccAWSNeptuneClusterConfigNamePrefix = `
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier_prefix = "tf-test-"
+  skip_final_snapshot       = true
+}
+`
+
+func testAccAWSNeptuneClusterConfigUpdates(rName string, iamAuth bool, backupRetention int, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier                  = "%s"
+  iam_database_authentication_enabled = %t
+  backup_retention_period             = %d
+  preferred_backup_window             = "07:00-09:00"
+  apply_immediately                   = true
+  skip_final_snapshot                 = true
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, iamAuth, backupRetention, tagValue)
+}
+
+func testAccAWSNeptuneClusterConfigKmsKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = "%[1]s"
+  storage_encrypted   = true
+  kms_key_arn         = "${aws_kms_key.test.arn}"
+  skip_final_snapshot = true
+}
+`, rName)
+}
+
+func testAccAWSNeptuneClusterConfigFinalSnapshot(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier        = "%[1]s"
+  final_snapshot_identifier = "%[1]s-final"
+}
+`, rName)
+}
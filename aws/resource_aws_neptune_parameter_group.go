@@ -256,7 +256,7 @@ func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("parameter")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsNeptune(conn, d, d.Get("arn").(string))
 		if err != nil {
 			return fmt.Errorf("error setting Neptune Parameter Group %q tags: %s", d.Id(), err)
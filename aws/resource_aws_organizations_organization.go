@@ -14,12 +14,37 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsOrganizationCreate,
 		Read:   resourceAwsOrganizationsOrganizationRead,
+		Update: resourceAwsOrganizationsOrganizationUpdate,
 		Delete: resourceAwsOrganizationsOrganizationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -46,6 +71,57 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 					organizations.OrganizationFeatureSetConsolidatedBilling,
 				}, true),
 			},
+			"aws_service_access_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled_policy_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						organizations.PolicyTypeServiceControlPolicy,
+					}, false),
+				},
+			},
+			"roots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -66,6 +142,25 @@ func resourceAwsOrganizationsOrganizationCreate(d *schema.ResourceData, meta int
 	org := resp.Organization
 	d.SetId(*org.Id)
 
+	for _, principal := range d.Get("aws_service_access_principals").(*schema.Set).List() {
+		if err := enableAwsOrganizationsServiceAccess(conn, principal.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("enabled_policy_types").(*schema.Set); v.Len() > 0 {
+		rootId, err := getAwsOrganizationsRootId(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, policyType := range v.List() {
+			if err := enableAwsOrganizationsPolicyType(conn, rootId, policyType.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAwsOrganizationsOrganizationRead(d, meta)
 }
 
@@ -88,9 +183,125 @@ func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta inter
 	d.Set("master_account_arn", org.Organization.MasterAccountArn)
 	d.Set("master_account_email", org.Organization.MasterAccountEmail)
 	d.Set("master_account_id", org.Organization.MasterAccountId)
+
+	var accounts []*organizations.Account
+	err = conn.ListAccountsPages(&organizations.ListAccountsInput{}, func(page *organizations.ListAccountsOutput, lastPage bool) bool {
+		accounts = append(accounts, page.Accounts...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing AWS Organization (%s) accounts: %s", d.Id(), err)
+	}
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	var roots []*organizations.Root
+	err = conn.ListRootsPages(&organizations.ListRootsInput{}, func(page *organizations.ListRootsOutput, lastPage bool) bool {
+		roots = append(roots, page.Roots...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing AWS Organization (%s) roots: %s", d.Id(), err)
+	}
+
+	if err := d.Set("roots", flattenOrganizationsRoots(roots)); err != nil {
+		return fmt.Errorf("error setting roots: %s", err)
+	}
+
+	enabledPolicyTypes := make([]string, 0)
+	if len(roots) > 0 {
+		for _, policyType := range roots[0].PolicyTypes {
+			if aws.StringValue(policyType.Status) == organizations.PolicyTypeStatusEnabled {
+				enabledPolicyTypes = append(enabledPolicyTypes, aws.StringValue(policyType.Type))
+			}
+		}
+	}
+
+	if err := d.Set("enabled_policy_types", enabledPolicyTypes); err != nil {
+		return fmt.Errorf("error setting enabled_policy_types: %s", err)
+	}
+
+	// Service access can only be listed in organizations with all features enabled
+	awsServiceAccessPrincipals := make([]string, 0)
+	if aws.StringValue(org.Organization.FeatureSet) == organizations.OrganizationFeatureSetAll {
+		err = conn.ListAWSServiceAccessForOrganizationPages(&organizations.ListAWSServiceAccessForOrganizationInput{}, func(page *organizations.ListAWSServiceAccessForOrganizationOutput, lastPage bool) bool {
+			for _, principal := range page.EnabledServicePrincipals {
+				awsServiceAccessPrincipals = append(awsServiceAccessPrincipals, aws.StringValue(principal.ServicePrincipal))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("error listing AWS Service Access for AWS Organization (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("aws_service_access_principals", awsServiceAccessPrincipals); err != nil {
+		return fmt.Errorf("error setting aws_service_access_principals: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsOrganizationsOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("aws_service_access_principals") {
+		o, n := d.GetChange("aws_service_access_principals")
+		oldSet := o.(*schema.Set)
+		newSet := n.(*schema.Set)
+
+		for _, principal := range oldSet.Difference(newSet).List() {
+			input := &organizations.DisableAWSServiceAccessInput{
+				ServicePrincipal: aws.String(principal.(string)),
+			}
+
+			log.Printf("[DEBUG] Disabling AWS Service Access in Organization: %s", input)
+			if _, err := conn.DisableAWSServiceAccess(input); err != nil {
+				return fmt.Errorf("error disabling AWS Service Access (%s) in Organization: %s", principal, err)
+			}
+		}
+
+		for _, principal := range newSet.Difference(oldSet).List() {
+			if err := enableAwsOrganizationsServiceAccess(conn, principal.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("enabled_policy_types") {
+		rootId, err := getAwsOrganizationsRootId(conn)
+		if err != nil {
+			return err
+		}
+
+		o, n := d.GetChange("enabled_policy_types")
+		oldSet := o.(*schema.Set)
+		newSet := n.(*schema.Set)
+
+		for _, policyType := range oldSet.Difference(newSet).List() {
+			input := &organizations.DisablePolicyTypeInput{
+				PolicyType: aws.String(policyType.(string)),
+				RootId:     aws.String(rootId),
+			}
+
+			log.Printf("[DEBUG] Disabling Policy Type in Organization: %s", input)
+			if _, err := conn.DisablePolicyType(input); err != nil {
+				return fmt.Errorf("error disabling Policy Type (%s) in Organization (%s): %s", policyType, rootId, err)
+			}
+		}
+
+		for _, policyType := range newSet.Difference(oldSet).List() {
+			if err := enableAwsOrganizationsPolicyType(conn, rootId, policyType.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationRead(d, meta)
+}
+
 func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).organizationsconn
 
@@ -103,3 +314,91 @@ func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func enableAwsOrganizationsServiceAccess(conn *organizations.Organizations, principal string) error {
+	input := &organizations.EnableAWSServiceAccessInput{
+		ServicePrincipal: aws.String(principal),
+	}
+
+	log.Printf("[DEBUG] Enabling AWS Service Access in Organization: %s", input)
+	if _, err := conn.EnableAWSServiceAccess(input); err != nil {
+		return fmt.Errorf("error enabling AWS Service Access (%s) in Organization: %s", principal, err)
+	}
+
+	return nil
+}
+
+func enableAwsOrganizationsPolicyType(conn *organizations.Organizations, rootId, policyType string) error {
+	input := &organizations.EnablePolicyTypeInput{
+		PolicyType: aws.String(policyType),
+		RootId:     aws.String(rootId),
+	}
+
+	log.Printf("[DEBUG] Enabling Policy Type in Organization: %s", input)
+	if _, err := conn.EnablePolicyType(input); err != nil {
+		return fmt.Errorf("error enabling Policy Type (%s) in Organization (%s): %s", policyType, rootId, err)
+	}
+
+	return nil
+}
+
+// getAwsOrganizationsRootId returns the ID of the single root of the
+// Organization, which policy types are enabled and disabled on.
+func getAwsOrganizationsRootId(conn *organizations.Organizations) (string, error) {
+	resp, err := conn.ListRoots(&organizations.ListRootsInput{})
+	if err != nil {
+		return "", fmt.Errorf("error listing Organization roots: %s", err)
+	}
+
+	if resp == nil || len(resp.Roots) == 0 {
+		return "", fmt.Errorf("no Organization roots found")
+	}
+
+	return aws.StringValue(resp.Roots[0].Id), nil
+}
+
+func flattenOrganizationsAccounts(accounts []*organizations.Account) []map[string]interface{} {
+	if len(accounts) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, account := range accounts {
+		result = append(result, map[string]interface{}{
+			"arn":   aws.StringValue(account.Arn),
+			"email": aws.StringValue(account.Email),
+			"id":    aws.StringValue(account.Id),
+			"name":  aws.StringValue(account.Name),
+		})
+	}
+	return result
+}
+
+func flattenOrganizationsRoots(roots []*organizations.Root) []map[string]interface{} {
+	if len(roots) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, r := range roots {
+		result = append(result, map[string]interface{}{
+			"id":           aws.StringValue(r.Id),
+			"name":         aws.StringValue(r.Name),
+			"arn":          aws.StringValue(r.Arn),
+			"policy_types": flattenOrganizationsRootPolicyTypeSummaries(r.PolicyTypes),
+		})
+	}
+	return result
+}
+
+func flattenOrganizationsRootPolicyTypeSummaries(summaries []*organizations.PolicyTypeSummary) []map[string]interface{} {
+	if len(summaries) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, s := range summaries {
+		result = append(result, map[string]interface{}{
+			"status": aws.StringValue(s.Status),
+			"type":   aws.StringValue(s.Type),
+		})
+	}
+	return result
+}
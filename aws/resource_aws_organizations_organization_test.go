@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -26,6 +27,90 @@ func testAccAwsOrganizationsOrganization_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "master_account_arn"),
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "master_account_email"),
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "feature_set"),
+					resource.TestCheckResourceAttr("aws_organizations_organization.test", "accounts.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_organizations_organization.test", "accounts.0.arn", "aws_organizations_organization.test", "master_account_arn"),
+					resource.TestCheckResourceAttr("aws_organizations_organization.test", "roots.#", "1"),
+					resource.TestMatchResourceAttr("aws_organizations_organization.test", "roots.0.id", regexp.MustCompile(`^r-[0-9a-z]{4,32}$`)),
+					resource.TestCheckResourceAttr("aws_organizations_organization.test", "aws_service_access_principals.#", "0"),
+					resource.TestCheckResourceAttr("aws_organizations_organization.test", "enabled_policy_types.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsOrganization_AwsServiceAccessPrincipals(t *testing.T) {
+	var organization organizations.Organization
+	resourceName := "aws_organizations_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsOrganizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsOrganizationConfigAwsServiceAccessPrincipals1("config.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationExists(resourceName, &organization),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.3141147945", "config.amazonaws.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsOrganizationsOrganizationConfigAwsServiceAccessPrincipals2("config.amazonaws.com", "ds.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationExists(resourceName, &organization),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.3141147945", "config.amazonaws.com"),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.495582846", "ds.amazonaws.com"),
+				),
+			},
+			{
+				Config: testAccAwsOrganizationsOrganizationConfigAwsServiceAccessPrincipals1("fms.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationExists(resourceName, &organization),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aws_service_access_principals.829925966", "fms.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsOrganization_EnabledPolicyTypes(t *testing.T) {
+	var organization organizations.Organization
+	resourceName := "aws_organizations_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsOrganizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsOrganizationConfigEnabledPolicyTypes1(organizations.PolicyTypeServiceControlPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationExists(resourceName, &organization),
+					resource.TestCheckResourceAttr(resourceName, "enabled_policy_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roots.0.policy_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roots.0.policy_types.0.type", organizations.PolicyTypeServiceControlPolicy),
+					resource.TestCheckResourceAttr(resourceName, "roots.0.policy_types.0.status", organizations.PolicyTypeStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsOrganizationsOrganizationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationExists(resourceName, &organization),
+					resource.TestCheckResourceAttr(resourceName, "enabled_policy_types.#", "0"),
 				),
 			},
 		},
@@ -119,3 +204,27 @@ resource "aws_organizations_organization" "test" {
 }
 `, feature_set)
 }
+
+func testAccAwsOrganizationsOrganizationConfigAwsServiceAccessPrincipals1(principal1 string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = [%q]
+}
+`, principal1)
+}
+
+func testAccAwsOrganizationsOrganizationConfigAwsServiceAccessPrincipals2(principal1, principal2 string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = [%q, %q]
+}
+`, principal1, principal2)
+}
+
+func testAccAwsOrganizationsOrganizationConfigEnabledPolicyTypes1(policyType1 string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = [%q]
+}
+`, policyType1)
+}
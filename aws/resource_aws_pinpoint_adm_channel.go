@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointADMChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointADMChannel, map[string]*schema.Schema{
+		"client_id": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"client_secret": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	})
+}
+
+var pinpointADMChannel = pinpointChannel{
+	kind: "ADM",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		_, err := conn.UpdateAdmChannel(&pinpoint.UpdateAdmChannelInput{
+			ApplicationId: aws.String(applicationID),
+			ADMChannelRequest: &pinpoint.ADMChannelRequest{
+				ClientId:     aws.String(d.Get("client_id").(string)),
+				ClientSecret: aws.String(d.Get("client_secret").(string)),
+				Enabled:      aws.Bool(d.Get("enabled").(bool)),
+			},
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetAdmChannel(&pinpoint.GetAdmChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("enabled", output.ADMChannelResponse.Enabled)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteAdmChannel(&pinpoint.DeleteAdmChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
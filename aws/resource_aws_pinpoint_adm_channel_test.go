@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointADMChannel_basic(t *testing.T) {
+	clientID := os.Getenv("ADM_CLIENT_ID")
+	clientSecret := os.Getenv("ADM_CLIENT_SECRET")
+	if clientID == "" || clientSecret == "" {
+		t.Skip("Environment variables ADM_CLIENT_ID and ADM_CLIENT_SECRET must be set")
+	}
+
+	var channel pinpoint.ADMChannelResponse
+	resourceName := "aws_pinpoint_adm_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointADMChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointADMChannelConfig(rName, true, clientID, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointADMChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointADMChannelConfig(rName, false, clientID, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointADMChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_id", "client_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointADMChannelExists(n string, channel *pinpoint.ADMChannelResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint ADM channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		output, err := conn.GetAdmChannel(&pinpoint.GetAdmChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*channel = *output.ADMChannelResponse
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPinpointADMChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_adm_channel" {
+			continue
+		}
+
+		_, err := conn.GetAdmChannel(&pinpoint.GetAdmChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint ADM channel for application %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointADMChannelConfig(rName string, enabled bool, clientID, clientSecret string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_adm_channel" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  enabled        = %[2]t
+
+  client_id     = %[3]q
+  client_secret = %[4]q
+}
+`, rName, enabled, clientID, clientSecret)
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointAPNSSandboxChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointAPNSSandboxChannel, pinpointApnsChannelSchema())
+}
+
+var pinpointAPNSSandboxChannel = pinpointChannel{
+	kind: "APNs sandbox",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		if err := validatePinpointApnsCredentials(d); err != nil {
+			return err
+		}
+
+		request := &pinpoint.APNSSandboxChannelRequest{
+			Enabled: aws.Bool(d.Get("enabled").(bool)),
+		}
+		if v, ok := d.GetOk("bundle_id"); ok {
+			request.BundleId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("certificate"); ok {
+			request.Certificate = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("default_authentication_method"); ok {
+			request.DefaultAuthenticationMethod = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("private_key"); ok {
+			request.PrivateKey = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("team_id"); ok {
+			request.TeamId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("token_key"); ok {
+			request.TokenKey = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("token_key_id"); ok {
+			request.TokenKeyId = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateApnsSandboxChannel(&pinpoint.UpdateApnsSandboxChannelInput{
+			ApplicationId:             aws.String(applicationID),
+			APNSSandboxChannelRequest: request,
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetApnsSandboxChannel(&pinpoint.GetApnsSandboxChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("default_authentication_method", output.APNSSandboxChannelResponse.DefaultAuthenticationMethod)
+		d.Set("enabled", output.APNSSandboxChannelResponse.Enabled)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteApnsSandboxChannel(&pinpoint.DeleteApnsSandboxChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
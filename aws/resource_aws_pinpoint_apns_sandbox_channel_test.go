@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointAPNSSandboxChannel_basic(t *testing.T) {
+	certificateFile := os.Getenv("APNS_CERTIFICATE_FILE")
+	privateKeyFile := os.Getenv("APNS_PRIVATE_KEY_FILE")
+	if certificateFile == "" || privateKeyFile == "" {
+		t.Skip("Environment variables APNS_CERTIFICATE_FILE and APNS_PRIVATE_KEY_FILE must be set")
+	}
+
+	var channel pinpoint.APNSSandboxChannelResponse
+	resourceName := "aws_pinpoint_apns_sandbox_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAPNSSandboxChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAPNSSandboxChannelConfig(rName, true, certificateFile, privateKeyFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointAPNSSandboxChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_authentication_method", "CERTIFICATE"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointAPNSSandboxChannelConfig(rName, false, certificateFile, privateKeyFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointAPNSSandboxChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointAPNSSandboxChannelExists(n string, channel *pinpoint.APNSSandboxChannelResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint APNs sandbox channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		output, err := conn.GetApnsSandboxChannel(&pinpoint.GetApnsSandboxChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*channel = *output.APNSSandboxChannelResponse
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPinpointAPNSSandboxChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_apns_sandbox_channel" {
+			continue
+		}
+
+		_, err := conn.GetApnsSandboxChannel(&pinpoint.GetApnsSandboxChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint APNs sandbox channel for application %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointAPNSSandboxChannelConfig(rName string, enabled bool, certificateFile, privateKeyFile string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_apns_sandbox_channel" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  enabled        = %[2]t
+
+  certificate = "${file(%[3]q)}"
+  private_key = "${file(%[4]q)}"
+}
+`, rName, enabled, certificateFile, privateKeyFile)
+}
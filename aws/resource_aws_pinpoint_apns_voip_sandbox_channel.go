@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointAPNSVoipSandboxChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointAPNSVoipSandboxChannel, pinpointApnsChannelSchema())
+}
+
+var pinpointAPNSVoipSandboxChannel = pinpointChannel{
+	kind: "APNs VoIP sandbox",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		if err := validatePinpointApnsCredentials(d); err != nil {
+			return err
+		}
+
+		request := &pinpoint.APNSVoipSandboxChannelRequest{
+			Enabled: aws.Bool(d.Get("enabled").(bool)),
+		}
+		if v, ok := d.GetOk("bundle_id"); ok {
+			request.BundleId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("certificate"); ok {
+			request.Certificate = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("default_authentication_method"); ok {
+			request.DefaultAuthenticationMethod = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("private_key"); ok {
+			request.PrivateKey = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("team_id"); ok {
+			request.TeamId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("token_key"); ok {
+			request.TokenKey = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("token_key_id"); ok {
+			request.TokenKeyId = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateApnsVoipSandboxChannel(&pinpoint.UpdateApnsVoipSandboxChannelInput{
+			ApplicationId:                 aws.String(applicationID),
+			APNSVoipSandboxChannelRequest: request,
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetApnsVoipSandboxChannel(&pinpoint.GetApnsVoipSandboxChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("default_authentication_method", output.APNSVoipSandboxChannelResponse.DefaultAuthenticationMethod)
+		d.Set("enabled", output.APNSVoipSandboxChannelResponse.Enabled)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteApnsVoipSandboxChannel(&pinpoint.DeleteApnsVoipSandboxChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
@@ -0,0 +1,335 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointAppCreate,
+		Read:   resourceAwsPinpointAppRead,
+		Update: resourceAwsPinpointAppUpdate,
+		Delete: resourceAwsPinpointAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"campaign_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_function_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								pinpoint.ModeDelivery,
+								pinpoint.ModeFilter,
+							}, false),
+						},
+						"web_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"maximum_duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"messages_per_second": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"quiet_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint app: %s", name)
+	output, err := conn.CreateApp(&pinpoint.CreateAppInput{
+		CreateApplicationRequest: &pinpoint.CreateApplicationRequest{
+			Name: aws.String(name),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint app (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationResponse.Id))
+
+	return resourceAwsPinpointAppUpdate(d, meta)
+}
+
+func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	app, err := conn.GetApp(&pinpoint.GetAppInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Pinpoint app (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Pinpoint app (%s): %s", d.Id(), err)
+	}
+
+	settings, err := conn.GetApplicationSettings(&pinpoint.GetApplicationSettingsInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint app (%s) settings: %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "mobiletargeting",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("apps/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	d.Set("application_id", app.ApplicationResponse.Id)
+	d.Set("name", app.ApplicationResponse.Name)
+
+	if err := d.Set("campaign_hook", flattenPinpointCampaignHook(settings.ApplicationSettingsResource.CampaignHook)); err != nil {
+		return fmt.Errorf("error setting campaign_hook: %s", err)
+	}
+	if err := d.Set("limits", flattenPinpointCampaignLimits(settings.ApplicationSettingsResource.Limits)); err != nil {
+		return fmt.Errorf("error setting limits: %s", err)
+	}
+	if err := d.Set("quiet_time", flattenPinpointQuietTime(settings.ApplicationSettingsResource.QuietTime)); err != nil {
+		return fmt.Errorf("error setting quiet_time: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	if d.HasChange("campaign_hook") || d.HasChange("limits") || d.HasChange("quiet_time") {
+		input := &pinpoint.UpdateApplicationSettingsInput{
+			ApplicationId: aws.String(d.Id()),
+			WriteApplicationSettingsRequest: &pinpoint.WriteApplicationSettingsRequest{
+				CampaignHook: expandPinpointCampaignHook(d.Get("campaign_hook").([]interface{})),
+				Limits:       expandPinpointCampaignLimits(d.Get("limits").([]interface{})),
+				QuietTime:    expandPinpointQuietTime(d.Get("quiet_time").([]interface{})),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint app (%s) settings: %s", d.Id(), input)
+		if _, err := conn.UpdateApplicationSettings(input); err != nil {
+			return fmt.Errorf("error updating Pinpoint app (%s) settings: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsPinpointAppRead(d, meta)
+}
+
+func resourceAwsPinpointAppDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint app: %s", d.Id())
+	_, err := conn.DeleteApp(&pinpoint.DeleteAppInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Pinpoint app (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// The settings blocks are always sent, even when removed from the
+// configuration, so that removing a block clears the setting.
+
+func expandPinpointCampaignHook(l []interface{}) *pinpoint.CampaignHook {
+	hook := &pinpoint.CampaignHook{}
+	if len(l) == 0 || l[0] == nil {
+		return hook
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["lambda_function_name"].(string); ok && v != "" {
+		hook.LambdaFunctionName = aws.String(v)
+	}
+	if v, ok := m["mode"].(string); ok && v != "" {
+		hook.Mode = aws.String(v)
+	}
+	if v, ok := m["web_url"].(string); ok && v != "" {
+		hook.WebUrl = aws.String(v)
+	}
+
+	return hook
+}
+
+func flattenPinpointCampaignHook(hook *pinpoint.CampaignHook) []interface{} {
+	if hook == nil || (hook.LambdaFunctionName == nil && hook.Mode == nil && hook.WebUrl == nil) {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"lambda_function_name": aws.StringValue(hook.LambdaFunctionName),
+		"mode":                 aws.StringValue(hook.Mode),
+		"web_url":              aws.StringValue(hook.WebUrl),
+	}}
+}
+
+func expandPinpointCampaignLimits(l []interface{}) *pinpoint.CampaignLimits {
+	limits := &pinpoint.CampaignLimits{}
+	if len(l) == 0 || l[0] == nil {
+		return limits
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["daily"].(int); ok && v != 0 {
+		limits.Daily = aws.Int64(int64(v))
+	}
+	if v, ok := m["maximum_duration"].(int); ok && v != 0 {
+		limits.MaximumDuration = aws.Int64(int64(v))
+	}
+	if v, ok := m["messages_per_second"].(int); ok && v != 0 {
+		limits.MessagesPerSecond = aws.Int64(int64(v))
+	}
+	if v, ok := m["total"].(int); ok && v != 0 {
+		limits.Total = aws.Int64(int64(v))
+	}
+
+	return limits
+}
+
+func flattenPinpointCampaignLimits(limits *pinpoint.CampaignLimits) []interface{} {
+	if limits == nil {
+		return []interface{}{}
+	}
+
+	daily := aws.Int64Value(limits.Daily)
+	maximumDuration := aws.Int64Value(limits.MaximumDuration)
+	messagesPerSecond := aws.Int64Value(limits.MessagesPerSecond)
+	total := aws.Int64Value(limits.Total)
+	if daily == 0 && maximumDuration == 0 && messagesPerSecond == 0 && total == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"daily":               int(daily),
+		"maximum_duration":    int(maximumDuration),
+		"messages_per_second": int(messagesPerSecond),
+		"total":               int(total),
+	}}
+}
+
+func expandPinpointQuietTime(l []interface{}) *pinpoint.QuietTime {
+	quietTime := &pinpoint.QuietTime{}
+	if len(l) == 0 || l[0] == nil {
+		return quietTime
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["end"].(string); ok && v != "" {
+		quietTime.End = aws.String(v)
+	}
+	if v, ok := m["start"].(string); ok && v != "" {
+		quietTime.Start = aws.String(v)
+	}
+
+	return quietTime
+}
+
+func flattenPinpointQuietTime(quietTime *pinpoint.QuietTime) []interface{} {
+	if quietTime == nil || (aws.StringValue(quietTime.End) == "" && aws.StringValue(quietTime.Start) == "") {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"end":   aws.StringValue(quietTime.End),
+		"start": aws.StringValue(quietTime.Start),
+	}}
+}
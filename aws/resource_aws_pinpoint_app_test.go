@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointApp_basic(t *testing.T) {
+	var application pinpoint.ApplicationResponse
+	resourceName := "aws_pinpoint_app.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAppConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName, &application),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:mobiletargeting:[^:]+:\d{12}:apps/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "campaign_hook.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointApp_settings(t *testing.T) {
+	var application pinpoint.ApplicationResponse
+	resourceName := "aws_pinpoint_app.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointAppConfigSettings(rName, 400, "00:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily", "400"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.maximum_duration", "600"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.messages_per_second", "50"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.total", "500"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.start", "00:00"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.end", "03:00"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointAppConfigSettings(rName, 300, "01:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily", "300"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.start", "01:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSPinpointAppConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointAppExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointAppExists(n string, application *pinpoint.ApplicationResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint app ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		output, err := conn.GetApp(&pinpoint.GetAppInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*application = *output.ApplicationResponse
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPinpointAppDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_app" {
+			continue
+		}
+
+		_, err := conn.GetApp(&pinpoint.GetAppInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint app %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointAppConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccAWSPinpointAppConfigSettings(rName string, daily int, quietTimeStart string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = "%s"
+
+  limits {
+    daily               = %d
+    maximum_duration    = 600
+    messages_per_second = 50
+    total               = 500
+  }
+
+  quiet_time {
+    start = "%s"
+    end   = "03:00"
+  }
+}
+`, rName, daily, quietTimeStart)
+}
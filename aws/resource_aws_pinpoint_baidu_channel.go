@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointBaiduChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointBaiduChannel, map[string]*schema.Schema{
+		"api_key": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"secret_key": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	})
+}
+
+var pinpointBaiduChannel = pinpointChannel{
+	kind: "Baidu",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		_, err := conn.UpdateBaiduChannel(&pinpoint.UpdateBaiduChannelInput{
+			ApplicationId: aws.String(applicationID),
+			BaiduChannelRequest: &pinpoint.BaiduChannelRequest{
+				ApiKey:    aws.String(d.Get("api_key").(string)),
+				Enabled:   aws.Bool(d.Get("enabled").(bool)),
+				SecretKey: aws.String(d.Get("secret_key").(string)),
+			},
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetBaiduChannel(&pinpoint.GetBaiduChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("enabled", output.BaiduChannelResponse.Enabled)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteBaiduChannel(&pinpoint.DeleteBaiduChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
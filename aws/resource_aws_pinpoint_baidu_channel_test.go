@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointBaiduChannel_basic(t *testing.T) {
+	apiKey := os.Getenv("BAIDU_API_KEY")
+	secretKey := os.Getenv("BAIDU_SECRET_KEY")
+	if apiKey == "" || secretKey == "" {
+		t.Skip("Environment variables BAIDU_API_KEY and BAIDU_SECRET_KEY must be set")
+	}
+
+	var channel pinpoint.BaiduChannelResponse
+	resourceName := "aws_pinpoint_baidu_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointBaiduChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointBaiduChannelConfig(rName, true, apiKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointBaiduChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointBaiduChannelConfig(rName, false, apiKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPinpointBaiduChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key", "secret_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointBaiduChannelExists(n string, channel *pinpoint.BaiduChannelResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Baidu channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		output, err := conn.GetBaiduChannel(&pinpoint.GetBaiduChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*channel = *output.BaiduChannelResponse
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPinpointBaiduChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_baidu_channel" {
+			continue
+		}
+
+		_, err := conn.GetBaiduChannel(&pinpoint.GetBaiduChannelInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Baidu channel for application %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointBaiduChannelConfig(rName string, enabled bool, apiKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_baidu_channel" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  enabled        = %[2]t
+
+  api_key    = %[3]q
+  secret_key = %[4]q
+}
+`, rName, enabled, apiKey, secretKey)
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointEmailChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointEmailChannel, map[string]*schema.Schema{
+		"from_address": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"identity": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+		"role_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+	})
+}
+
+var pinpointEmailChannel = pinpointChannel{
+	kind: "email",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		_, err := conn.UpdateEmailChannel(&pinpoint.UpdateEmailChannelInput{
+			ApplicationId: aws.String(applicationID),
+			EmailChannelRequest: &pinpoint.EmailChannelRequest{
+				Enabled:     aws.Bool(d.Get("enabled").(bool)),
+				FromAddress: aws.String(d.Get("from_address").(string)),
+				Identity:    aws.String(d.Get("identity").(string)),
+				RoleArn:     aws.String(d.Get("role_arn").(string)),
+			},
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetEmailChannel(&pinpoint.GetEmailChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("enabled", output.EmailChannelResponse.Enabled)
+		d.Set("from_address", output.EmailChannelResponse.FromAddress)
+		d.Set("identity", output.EmailChannelResponse.Identity)
+		d.Set("role_arn", output.EmailChannelResponse.RoleArn)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteEmailChannel(&pinpoint.DeleteEmailChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
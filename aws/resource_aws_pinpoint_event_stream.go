@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointEventStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointEventStreamUpsert,
+		Read:   resourceAwsPinpointEventStreamRead,
+		Update: resourceAwsPinpointEventStreamUpsert,
+		Delete: resourceAwsPinpointEventStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsPinpointEventStreamUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationID := d.Get("application_id").(string)
+	input := &pinpoint.PutEventStreamInput{
+		ApplicationId: aws.String(applicationID),
+		WriteEventStream: &pinpoint.WriteEventStream{
+			DestinationStreamArn: aws.String(d.Get("destination_stream_arn").(string)),
+			RoleArn:              aws.String(d.Get("role_arn").(string)),
+		},
+	}
+
+	// IAM roles take some time to propagate
+	log.Printf("[DEBUG] Putting Pinpoint event stream: %s", input)
+	err := resource.Retry(30*time.Second, func() *resource.RetryError {
+		_, err := conn.PutEventStream(input)
+		if err != nil {
+			if isAWSErr(err, pinpoint.ErrCodeBadRequestException, "make sure the IAM Role is configured correctly") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Pinpoint event stream for application %s: %s", applicationID, err)
+	}
+
+	d.SetId(applicationID)
+
+	return resourceAwsPinpointEventStreamRead(d, meta)
+}
+
+func resourceAwsPinpointEventStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetEventStream(&pinpoint.GetEventStreamInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Pinpoint event stream for application %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Pinpoint event stream for application %s: %s", d.Id(), err)
+	}
+
+	d.Set("application_id", output.EventStream.ApplicationId)
+	d.Set("destination_stream_arn", output.EventStream.DestinationStreamArn)
+	d.Set("role_arn", output.EventStream.RoleArn)
+
+	return nil
+}
+
+func resourceAwsPinpointEventStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint event stream for application %s", d.Id())
+	_, err := conn.DeleteEventStream(&pinpoint.DeleteEventStreamInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Pinpoint event stream for application %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
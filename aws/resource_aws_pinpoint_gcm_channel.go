@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointGCMChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointGCMChannel, map[string]*schema.Schema{
+		"api_key": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	})
+}
+
+var pinpointGCMChannel = pinpointChannel{
+	kind: "GCM",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		_, err := conn.UpdateGcmChannel(&pinpoint.UpdateGcmChannelInput{
+			ApplicationId: aws.String(applicationID),
+			GCMChannelRequest: &pinpoint.GCMChannelRequest{
+				ApiKey:  aws.String(d.Get("api_key").(string)),
+				Enabled: aws.Bool(d.Get("enabled").(bool)),
+			},
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetGcmChannel(&pinpoint.GetGcmChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("enabled", output.GCMChannelResponse.Enabled)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteGcmChannel(&pinpoint.DeleteGcmChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
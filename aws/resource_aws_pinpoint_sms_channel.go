@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsPinpointSMSChannel() *schema.Resource {
+	return resourceAwsPinpointChannel(&pinpointSMSChannel, map[string]*schema.Schema{
+		"sender_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"short_code": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	})
+}
+
+var pinpointSMSChannel = pinpointChannel{
+	kind: "SMS",
+
+	update: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		request := &pinpoint.SMSChannelRequest{
+			Enabled: aws.Bool(d.Get("enabled").(bool)),
+		}
+		if v, ok := d.GetOk("sender_id"); ok {
+			request.SenderId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("short_code"); ok {
+			request.ShortCode = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateSmsChannel(&pinpoint.UpdateSmsChannelInput{
+			ApplicationId:     aws.String(applicationID),
+			SMSChannelRequest: request,
+		})
+		return err
+	},
+
+	get: func(conn *pinpoint.Pinpoint, applicationID string, d *schema.ResourceData) error {
+		output, err := conn.GetSmsChannel(&pinpoint.GetSmsChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Set("enabled", output.SMSChannelResponse.Enabled)
+		d.Set("sender_id", output.SMSChannelResponse.SenderId)
+		d.Set("short_code", output.SMSChannelResponse.ShortCode)
+
+		return nil
+	},
+
+	remove: func(conn *pinpoint.Pinpoint, applicationID string) error {
+		_, err := conn.DeleteSmsChannel(&pinpoint.DeleteSmsChannelInput{
+			ApplicationId: aws.String(applicationID),
+		})
+		return err
+	},
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func resourceAwsRoute53TrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyCreate,
+		Read:   resourceAwsRoute53TrafficPolicyRead,
+		Update: resourceAwsRoute53TrafficPolicyUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsRoute53TrafficPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// A new policy version is created whenever the document changes.
+func resourceAwsRoute53TrafficPolicyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("document") {
+		d.SetNewComputed("version")
+	}
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInput{
+		Document: aws.String(d.Get("document").(string)),
+		Name:     aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route53 traffic policy: %s", input)
+	out, err := r53.CreateTrafficPolicy(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Route53 traffic policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrafficPolicy.Id))
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	policy, err := getRoute53TrafficPolicyLatestVersion(r53, d.Id())
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			log.Printf("[WARN] Route53 traffic policy %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 traffic policy (%s): %s", d.Id(), err)
+	}
+
+	if policy == nil {
+		log.Printf("[WARN] Route53 traffic policy %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("comment", policy.Comment)
+	d.Set("document", policy.Document)
+	d.Set("name", policy.Name)
+	d.Set("type", policy.Type)
+	d.Set("version", policy.Version)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(d.Get("document").(string)),
+			Id:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route53 traffic policy version: %s", input)
+		if _, err := r53.CreateTrafficPolicyVersion(input); err != nil {
+			return fmt.Errorf("Error creating Route53 traffic policy (%s) version: %s", d.Id(), err)
+		}
+
+		return resourceAwsRoute53TrafficPolicyRead(d, meta)
+	}
+
+	if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Route53 traffic policy comment: %s", input)
+		if _, err := r53.UpdateTrafficPolicyComment(input); err != nil {
+			return fmt.Errorf("Error updating Route53 traffic policy (%s) comment: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	versions, err := listRoute53TrafficPolicyVersions(r53, d.Id())
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			return nil
+		}
+		return fmt.Errorf("Error listing Route53 traffic policy (%s) versions: %s", d.Id(), err)
+	}
+
+	// A traffic policy is only removed once all of its versions are deleted
+	for _, version := range versions {
+		input := &route53.DeleteTrafficPolicyInput{
+			Id:      aws.String(d.Id()),
+			Version: version.Version,
+		}
+
+		log.Printf("[DEBUG] Deleting Route53 traffic policy version: %s", input)
+		if _, err := r53.DeleteTrafficPolicy(input); err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return fmt.Errorf("Error deleting Route53 traffic policy (%s) version %d: %s", d.Id(), aws.Int64Value(version.Version), err)
+		}
+	}
+
+	return nil
+}
+
+func listRoute53TrafficPolicyVersions(r53 *route53.Route53, id string) ([]*route53.TrafficPolicy, error) {
+	var versions []*route53.TrafficPolicy
+
+	input := &route53.ListTrafficPolicyVersionsInput{
+		Id: aws.String(id),
+	}
+
+	for {
+		out, err := r53.ListTrafficPolicyVersions(input)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, out.TrafficPolicies...)
+
+		if !aws.BoolValue(out.IsTruncated) {
+			return versions, nil
+		}
+		input.TrafficPolicyVersionMarker = out.TrafficPolicyVersionMarker
+	}
+}
+
+func getRoute53TrafficPolicyLatestVersion(r53 *route53.Route53, id string) (*route53.TrafficPolicy, error) {
+	versions, err := listRoute53TrafficPolicyVersions(r53, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *route53.TrafficPolicy
+	for _, version := range versions {
+		if latest == nil || aws.Int64Value(version.Version) > aws.Int64Value(latest.Version) {
+			latest = version
+		}
+	}
+
+	return latest, nil
+}
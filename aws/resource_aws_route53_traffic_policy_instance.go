@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func resourceAwsRoute53TrafficPolicyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyInstanceCreate,
+		Read:   resourceAwsRoute53TrafficPolicyInstanceRead,
+		Update: resourceAwsRoute53TrafficPolicyInstanceUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+				},
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"traffic_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"traffic_policy_version": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInstanceInput{
+		HostedZoneId:         aws.String(cleanZoneID(d.Get("hosted_zone_id").(string))),
+		Name:                 aws.String(d.Get("name").(string)),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Route53 traffic policy instance: %s", input)
+	out, err := r53.CreateTrafficPolicyInstance(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Route53 traffic policy instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrafficPolicyInstance.Id))
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(r53, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for Route53 traffic policy instance (%s) to be applied: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	out, err := r53.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			log.Printf("[WARN] Route53 traffic policy instance %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	instance := out.TrafficPolicyInstance
+
+	d.Set("hosted_zone_id", cleanZoneID(aws.StringValue(instance.HostedZoneId)))
+	d.Set("name", strings.ToLower(strings.TrimSuffix(aws.StringValue(instance.Name), ".")))
+	d.Set("state", instance.State)
+	d.Set("traffic_policy_id", instance.TrafficPolicyId)
+	d.Set("traffic_policy_version", instance.TrafficPolicyVersion)
+	d.Set("ttl", instance.TTL)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	input := &route53.UpdateTrafficPolicyInstanceInput{
+		Id:                   aws.String(d.Id()),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating Route53 traffic policy instance: %s", input)
+	if _, err := r53.UpdateTrafficPolicyInstance(input); err != nil {
+		return fmt.Errorf("Error updating Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(r53, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for Route53 traffic policy instance (%s) to be applied: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	r53 := meta.(*AWSClient).r53conn
+
+	log.Printf("[DEBUG] Deleting Route53 traffic policy instance: %s", d.Id())
+	_, err := r53.DeleteTrafficPolicyInstance(&route53.DeleteTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Target:  []string{""},
+		Refresh: func() (interface{}, string, error) {
+			out, err := r53.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+				Id: aws.String(d.Id()),
+			})
+			if err != nil {
+				if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+					return 1, "", nil
+				}
+				return nil, "", err
+			}
+			return out, aws.StringValue(out.TrafficPolicyInstance.State), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Route53 traffic policy instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForRoute53TrafficPolicyInstanceApplied(r53 *route53.Route53, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Creating", "Updating"},
+		Target:  []string{"Applied"},
+		Refresh: func() (interface{}, string, error) {
+			out, err := r53.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+				Id: aws.String(id),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			instance := out.TrafficPolicyInstance
+			state := aws.StringValue(instance.State)
+			if state == "Failed" {
+				return out, state, fmt.Errorf("%s", aws.StringValue(instance.Message))
+			}
+
+			return out, state, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
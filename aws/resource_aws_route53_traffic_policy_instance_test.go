@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicyInstance_basic(t *testing.T) {
+	resourceName := "aws_route53_traffic_policy_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("www.%s.com", rName)),
+					resource.TestCheckResourceAttr(resourceName, "state", "Applied"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 traffic policy instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy_instance" {
+			continue
+		}
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Route53 traffic policy instance %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRoute53TrafficPolicyInstanceConfig(rName string, ttl int) string {
+	return testAccRoute53TrafficPolicyConfig(rName, "test", "10.0.0.1") + fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s.com"
+}
+
+resource "aws_route53_traffic_policy_instance" "test" {
+  hosted_zone_id         = "${aws_route53_zone.test.zone_id}"
+  name                   = "www.%[1]s.com"
+  traffic_policy_id      = "${aws_route53_traffic_policy.test.id}"
+  traffic_policy_version = "${aws_route53_traffic_policy.test.version}"
+  ttl                    = %[2]d
+}
+`, rName, ttl)
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicy_basic(t *testing.T) {
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "first", "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "first"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "second", "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "second"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "second", "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 traffic policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		policy, err := getRoute53TrafficPolicyLatestVersion(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("Route53 traffic policy %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy" {
+			continue
+		}
+
+		policy, err := getRoute53TrafficPolicyLatestVersion(conn, rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return err
+		}
+		if policy != nil {
+			return fmt.Errorf("Route53 traffic policy %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRoute53TrafficPolicyConfig(rName, comment, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name    = %q
+  comment = %q
+
+  document = <<EOF
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "primary": {
+      "Type": "value",
+      "Value": %q
+    }
+  },
+  "StartEndpoint": "primary"
+}
+EOF
+}
+`, rName, comment, address)
+}
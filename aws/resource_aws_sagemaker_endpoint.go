@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointCreate,
+		Read:   resourceAwsSagemakerEndpointRead,
+		Update: resourceAwsSagemakerEndpointUpdate,
+		Delete: resourceAwsSagemakerEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := resource.UniqueId()
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	input := &sagemaker.CreateEndpointInput{
+		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		EndpointName:       aws.String(name),
+		Tags:               tagsFromMapSagemaker(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker endpoint: %s", input)
+	if _, err := conn.CreateEndpoint(input); err != nil {
+		return fmt.Errorf("error creating SageMaker endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for SageMaker endpoint (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			log.Printf("[WARN] SageMaker endpoint (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading SageMaker endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.EndpointArn)
+	d.Set("endpoint_config_name", output.EndpointConfigName)
+	d.Set("name", output.EndpointName)
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(output.EndpointArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker endpoint (%s): %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapSagemaker(tags))
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for SageMaker endpoint (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("endpoint_config_name") {
+		input := &sagemaker.UpdateEndpointInput{
+			EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+			EndpointName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker endpoint: %s", input)
+		if _, err := conn.UpdateEndpoint(input); err != nil {
+			return fmt.Errorf("error updating SageMaker endpoint (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for SageMaker endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			return nil
+		}
+		return fmt.Errorf("error deleting SageMaker endpoint (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sagemaker.EndpointStatusDeleting},
+		Target:     []string{},
+		Refresh:    sagemakerEndpointStatusRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for SageMaker endpoint (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForSagemakerEndpointInService waits for an endpoint that is being
+// created or updated to reach the InService status. A failed update rolls
+// back to InService with the previous endpoint configuration, so rolling back
+// ends the wait with an error.
+func waitForSagemakerEndpointInService(conn *sagemaker.SageMaker, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			sagemaker.EndpointStatusCreating,
+			sagemaker.EndpointStatusUpdating,
+		},
+		Target:     []string{sagemaker.EndpointStatusInService},
+		Refresh:    sagemakerEndpointStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func sagemakerEndpointStatusRefreshFunc(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.EndpointStatus)
+		if status == sagemaker.EndpointStatusFailed {
+			return output, status, fmt.Errorf("endpoint failed: %s", aws.StringValue(output.FailureReason))
+		}
+		if status == sagemaker.EndpointStatusRollingBack {
+			return output, status, fmt.Errorf("endpoint update failed and is rolling back: %s", aws.StringValue(output.FailureReason))
+		}
+
+		return output, status, nil
+	}
+}
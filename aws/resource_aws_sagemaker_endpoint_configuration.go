@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointConfigurationCreate,
+		Read:   resourceAwsSagemakerEndpointConfigurationRead,
+		Update: resourceAwsSagemakerEndpointConfigurationUpdate,
+		Delete: resourceAwsSagemakerEndpointConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"production_variants": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_instance_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"initial_variant_weight": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							ForceNew: true,
+							Default:  1.0,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"model_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"variant_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := resource.UniqueId()
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	input := &sagemaker.CreateEndpointConfigInput{
+		EndpointConfigName: aws.String(name),
+		ProductionVariants: expandSagemakerProductionVariants(d.Get("production_variants").([]interface{})),
+		Tags:               tagsFromMapSagemaker(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker endpoint configuration: %s", input)
+	if _, err := conn.CreateEndpointConfig(input); err != nil {
+		return fmt.Errorf("error creating SageMaker endpoint configuration (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+			log.Printf("[WARN] SageMaker endpoint configuration (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading SageMaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.EndpointConfigArn)
+	d.Set("kms_key_arn", output.KmsKeyId)
+	d.Set("name", output.EndpointConfigName)
+
+	if err := d.Set("production_variants", flattenSagemakerProductionVariants(output.ProductionVariants)); err != nil {
+		return fmt.Errorf("error setting production_variants: %s", err)
+	}
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(output.EndpointConfigArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapSagemaker(tags))
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for SageMaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker endpoint configuration: %s", d.Id())
+	_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+			return nil
+		}
+		return fmt.Errorf("error deleting SageMaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerProductionVariants(l []interface{}) []*sagemaker.ProductionVariant {
+	variants := make([]*sagemaker.ProductionVariant, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		variant := &sagemaker.ProductionVariant{
+			InitialInstanceCount: aws.Int64(int64(m["initial_instance_count"].(int))),
+			InitialVariantWeight: aws.Float64(m["initial_variant_weight"].(float64)),
+			InstanceType:         aws.String(m["instance_type"].(string)),
+			ModelName:            aws.String(m["model_name"].(string)),
+			VariantName:          aws.String(resource.UniqueId()),
+		}
+
+		if v, ok := m["variant_name"].(string); ok && v != "" {
+			variant.VariantName = aws.String(v)
+		}
+
+		variants = append(variants, variant)
+	}
+
+	return variants
+}
+
+func flattenSagemakerProductionVariants(variants []*sagemaker.ProductionVariant) []interface{} {
+	result := make([]interface{}, 0, len(variants))
+
+	for _, variant := range variants {
+		result = append(result, map[string]interface{}{
+			"initial_instance_count": int(aws.Int64Value(variant.InitialInstanceCount)),
+			"initial_variant_weight": aws.Float64Value(variant.InitialVariantWeight),
+			"instance_type":          aws.StringValue(variant.InstanceType),
+			"model_name":             aws.StringValue(variant.ModelName),
+			"variant_name":           aws.StringValue(variant.VariantName),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSagemakerEndpointStatusRefreshFunc(t *testing.T) {
+	cases := map[string]struct {
+		Status      string
+		ExpectError string
+	}{
+		"in service": {
+			Status: sagemaker.EndpointStatusInService,
+		},
+		"updating": {
+			Status: sagemaker.EndpointStatusUpdating,
+		},
+		"failed": {
+			Status:      sagemaker.EndpointStatusFailed,
+			ExpectError: `endpoint failed: boom`,
+		},
+		"rolling back": {
+			Status:      sagemaker.EndpointStatusRollingBack,
+			ExpectError: `endpoint update failed and is rolling back: boom`,
+		},
+	}
+
+	conn := sagemaker.New(session.New(nil))
+
+	for name, tc := range cases {
+		conn.Handlers.Clear()
+		conn.Handlers.Send.PushBack(func(r *request.Request) {
+			data := r.Data.(*sagemaker.DescribeEndpointOutput)
+			data.EndpointStatus = aws.String(tc.Status)
+			data.FailureReason = aws.String("boom")
+		})
+
+		_, status, err := sagemakerEndpointStatusRefreshFunc(conn, "test")()
+		if status != tc.Status {
+			t.Errorf("%s: expected status %s, got %s", name, tc.Status, status)
+		}
+		if tc.ExpectError == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", name, err)
+			}
+			continue
+		}
+		if err == nil || !regexp.MustCompile(tc.ExpectError).MatchString(err.Error()) {
+			t.Errorf("%s: expected error matching %q, got: %v", name, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
+	resourceName := "aws_sagemaker_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:sagemaker:[^:]+:\d{12}:endpoint/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.test", "name"),
+				),
+			},
+			{
+				Config: testAccSagemakerEndpointConfig(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.updated", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerEndpointExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.EndpointStatus); status != sagemaker.EndpointStatusInService {
+			return fmt.Errorf("SageMaker endpoint %q is %s, expected %s", rs.Primary.ID, status, sagemaker.EndpointStatusInService)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint" {
+			continue
+		}
+
+		_, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SageMaker endpoint %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSagemakerEndpointConfig(rName, endpointConfig string) string {
+	return testAccSagemakerModelConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = "%[1]s"
+
+  production_variants {
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+
+resource "aws_sagemaker_endpoint_configuration" "updated" {
+  name = "%[1]s-updated"
+
+  production_variants {
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = "%[1]s"
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.%[2]s.name}"
+}
+`, rName, endpointConfig)
+}
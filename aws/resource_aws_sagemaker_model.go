@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerModelCreate,
+		Read:   resourceAwsSagemakerModelRead,
+		Update: resourceAwsSagemakerModelUpdate,
+		Delete: resourceAwsSagemakerModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"primary_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_hostname": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"model_data_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := resource.UniqueId()
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	input := &sagemaker.CreateModelInput{
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		ModelName:        aws.String(name),
+		PrimaryContainer: expandSagemakerContainerDefinition(d.Get("primary_container").([]interface{})),
+		Tags:             tagsFromMapSagemaker(d.Get("tags").(map[string]interface{})),
+		VpcConfig:        expandSagemakerVpcConfig(d.Get("vpc_config").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker model: %s", input)
+	// The execution role may not yet be assumable by SageMaker if it was just created.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateModel(input)
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not assume role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			log.Printf("[WARN] SageMaker model (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading SageMaker model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ModelArn)
+	d.Set("execution_role_arn", output.ExecutionRoleArn)
+	d.Set("name", output.ModelName)
+
+	if err := d.Set("primary_container", flattenSagemakerContainerDefinition(output.PrimaryContainer)); err != nil {
+		return fmt.Errorf("error setting primary_container: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSagemakerVpcConfig(output.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(output.ModelArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker model (%s): %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapSagemaker(tags))
+
+	return nil
+}
+
+func resourceAwsSagemakerModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for SageMaker model (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker model: %s", d.Id())
+	_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			return nil
+		}
+		return fmt.Errorf("error deleting SageMaker model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerContainerDefinition(l []interface{}) *sagemaker.ContainerDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	container := &sagemaker.ContainerDefinition{
+		Image: aws.String(m["image"].(string)),
+	}
+
+	if v, ok := m["container_hostname"].(string); ok && v != "" {
+		container.ContainerHostname = aws.String(v)
+	}
+	if v, ok := m["model_data_url"].(string); ok && v != "" {
+		container.ModelDataUrl = aws.String(v)
+	}
+	if v, ok := m["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		container.Environment = stringMapToPointers(v)
+	}
+
+	return container
+}
+
+func flattenSagemakerContainerDefinition(container *sagemaker.ContainerDefinition) []interface{} {
+	if container == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_hostname": aws.StringValue(container.ContainerHostname),
+		"environment":        aws.StringValueMap(container.Environment),
+		"image":              aws.StringValue(container.Image),
+		"model_data_url":     aws.StringValue(container.ModelDataUrl),
+	}
+
+	return []interface{}{m}
+}
+
+func expandSagemakerVpcConfig(l []interface{}) *sagemaker.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	return &sagemaker.VpcConfig{
+		SecurityGroupIds: expandStringSet(m["security_group_ids"].(*schema.Set)),
+		Subnets:          expandStringSet(m["subnets"].(*schema.Set)),
+	}
+}
+
+func flattenSagemakerVpcConfig(config *sagemaker.VpcConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"security_group_ids": schema.NewSet(schema.HashString, flattenStringList(config.SecurityGroupIds)),
+		"subnets":            schema.NewSet(schema.HashString, flattenStringList(config.Subnets)),
+	}
+
+	return []interface{}{m}
+}
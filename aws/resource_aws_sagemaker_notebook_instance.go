@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerNotebookInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceRead,
+		Update: resourceAwsSagemakerNotebookInstanceUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"direct_internet_access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sagemaker.DirectInternetAccessEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					sagemaker.DirectInternetAccessDisabled,
+					sagemaker.DirectInternetAccessEnabled,
+				}, false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"lifecycle_config_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+	name := d.Get("name").(string)
+
+	input := &sagemaker.CreateNotebookInstanceInput{
+		DirectInternetAccess: aws.String(d.Get("direct_internet_access").(string)),
+		InstanceType:         aws.String(d.Get("instance_type").(string)),
+		NotebookInstanceName: aws.String(name),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 tagsFromMapSagemaker(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("lifecycle_config_name"); ok {
+		input.LifecycleConfigName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_groups"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker notebook instance: %s", input)
+	if _, err := conn.CreateNotebookInstance(input); err != nil {
+		return fmt.Errorf("error creating SageMaker notebook instance (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), sagemaker.NotebookInstanceStatusInService, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for SageMaker notebook instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			log.Printf("[WARN] SageMaker notebook instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading SageMaker notebook instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.NotebookInstanceArn)
+	d.Set("direct_internet_access", output.DirectInternetAccess)
+	d.Set("instance_type", output.InstanceType)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("lifecycle_config_name", output.NotebookInstanceLifecycleConfigName)
+	d.Set("name", output.NotebookInstanceName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("subnet_id", output.SubnetId)
+	d.Set("url", output.Url)
+
+	if err := d.Set("security_groups", flattenStringList(output.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_groups: %s", err)
+	}
+
+	tags, err := listTagsSagemaker(conn, aws.StringValue(output.NotebookInstanceArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker notebook instance (%s): %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapSagemaker(tags))
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := setTagsSagemaker(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags for SageMaker notebook instance (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("instance_type") || d.HasChange("role_arn") {
+		input := &sagemaker.UpdateNotebookInstanceInput{
+			NotebookInstanceName: aws.String(d.Id()),
+		}
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		// A notebook instance can only be updated while it is stopped, so a
+		// running instance is stopped first and started again afterwards.
+		status, err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker notebook instance: %s", input)
+		if _, err := conn.UpdateNotebookInstance(input); err != nil {
+			return fmt.Errorf("error updating SageMaker notebook instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), sagemaker.NotebookInstanceStatusStopped, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for SageMaker notebook instance (%s) to be updated: %s", d.Id(), err)
+		}
+
+		if status == sagemaker.NotebookInstanceStatusInService {
+			log.Printf("[DEBUG] Starting SageMaker notebook instance: %s", d.Id())
+			_, err := conn.StartNotebookInstance(&sagemaker.StartNotebookInstanceInput{
+				NotebookInstanceName: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("error starting SageMaker notebook instance (%s): %s", d.Id(), err)
+			}
+
+			if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), sagemaker.NotebookInstanceStatusInService, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for SageMaker notebook instance (%s) to start: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if _, err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SageMaker notebook instance: %s", d.Id())
+	_, err := conn.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return fmt.Errorf("error deleting SageMaker notebook instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sagemaker.NotebookInstanceStatusDeleting},
+		Target:     []string{},
+		Refresh:    sagemakerNotebookInstanceStatusRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for SageMaker notebook instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// stopSagemakerNotebookInstance stops a notebook instance unless it is
+// already stopped or failed, and waits until it is stopped. It returns the
+// status the instance had before it was stopped.
+func stopSagemakerNotebookInstance(conn *sagemaker.SageMaker, name string, timeout time.Duration) (string, error) {
+	output, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	status := aws.StringValue(output.NotebookInstanceStatus)
+	switch status {
+	case sagemaker.NotebookInstanceStatusStopped, sagemaker.NotebookInstanceStatusFailed:
+		return status, nil
+	case sagemaker.NotebookInstanceStatusPending:
+		// An instance can't be stopped until it has finished starting.
+		if err := waitForSagemakerNotebookInstanceStatus(conn, name, sagemaker.NotebookInstanceStatusInService, timeout); err != nil {
+			return status, fmt.Errorf("error waiting for SageMaker notebook instance (%s) to start: %s", name, err)
+		}
+		status = sagemaker.NotebookInstanceStatusInService
+	}
+
+	if status != sagemaker.NotebookInstanceStatusStopping {
+		log.Printf("[DEBUG] Stopping SageMaker notebook instance: %s", name)
+		_, err := conn.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+			NotebookInstanceName: aws.String(name),
+		})
+		if err != nil {
+			return status, fmt.Errorf("error stopping SageMaker notebook instance (%s): %s", name, err)
+		}
+	}
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, name, sagemaker.NotebookInstanceStatusStopped, timeout); err != nil {
+		return status, fmt.Errorf("error waiting for SageMaker notebook instance (%s) to stop: %s", name, err)
+	}
+
+	return status, nil
+}
+
+func waitForSagemakerNotebookInstanceStatus(conn *sagemaker.SageMaker, name, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			sagemaker.NotebookInstanceStatusPending,
+			sagemaker.NotebookInstanceStatusStopping,
+			// Not yet defined as a constant by the SDK
+			"Updating",
+		},
+		Target:     []string{target},
+		Refresh:    sagemakerNotebookInstanceStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func sagemakerNotebookInstanceStatusRefreshFunc(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "RecordNotFound") {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.NotebookInstanceStatus)
+		if status == sagemaker.NotebookInstanceStatusFailed {
+			return output, status, fmt.Errorf("notebook instance failed: %s", aws.StringValue(output.FailureReason))
+		}
+
+		return output, status, nil
+	}
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogLaunchConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogLaunchConstraintCreate,
+		Read:   resourceAwsServiceCatalogLaunchConstraintRead,
+		Update: resourceAwsServiceCatalogLaunchConstraintUpdate,
+		Delete: resourceAwsServiceCatalogLaunchConstraintDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: serviceCatalogConstraintSchema(map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		}),
+	}
+}
+
+type serviceCatalogLaunchConstraintParameters struct {
+	RoleArn string `json:"RoleArn"`
+}
+
+func resourceAwsServiceCatalogLaunchConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	params, err := json.Marshal(&serviceCatalogLaunchConstraintParameters{
+		RoleArn: d.Get("role_arn").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := createServiceCatalogConstraint(conn, d, "LAUNCH", string(params)); err != nil {
+		return err
+	}
+
+	return resourceAwsServiceCatalogLaunchConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogLaunchConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	params, err := readServiceCatalogConstraint(conn, d)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return nil
+	}
+
+	var p serviceCatalogLaunchConstraintParameters
+	if err := json.Unmarshal([]byte(aws.StringValue(params)), &p); err != nil {
+		return fmt.Errorf("error parsing Service Catalog Launch Constraint (%s) parameters: %s", d.Id(), err)
+	}
+	d.Set("role_arn", p.RoleArn)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogLaunchConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	if err := updateServiceCatalogConstraint(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsServiceCatalogLaunchConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogLaunchConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteServiceCatalogConstraint(meta.(*AWSClient).scconn, d)
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		ProductId:        aws.String(productID),
+		Parameters: &servicecatalog.ProvisioningArtifactProperties{
+			Info: map[string]*string{
+				"LoadTemplateFromURL": aws.String(d.Get("template_url").(string)),
+			},
+			Type: aws.String(d.Get("type").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Parameters.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Parameters.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", input)
+	resp, err := conn.CreateProvisioningArtifact(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioning Artifact: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", productID, aws.StringValue(resp.ProvisioningArtifactDetail.Id)))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+				AcceptLanguage:         aws.String("en"),
+				ProductId:              aws.String(productID),
+				ProvisioningArtifactId: resp.ProvisioningArtifactDetail.Id,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			status := aws.StringValue(resp.Status)
+			if status == servicecatalog.StatusFailed {
+				return resp, status, fmt.Errorf("Service Catalog Provisioning Artifact (%s) creation failed", d.Id())
+			}
+			return resp, status, nil
+		},
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioning Artifact (%s) to become available: %s", d.Id(), err)
+	}
+
+	// The active flag cannot be set on creation
+	if !d.Get("active").(bool) {
+		return resourceAwsServiceCatalogProvisioningArtifactUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+		Verbose:                aws.Bool(true),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Provisioning Artifact %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	detail := resp.ProvisioningArtifactDetail
+
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", detail.Description)
+	d.Set("name", detail.Name)
+	d.Set("product_id", productID)
+	d.Set("provisioning_artifact_id", detail.Id)
+	d.Set("type", detail.Type)
+	if v, ok := resp.Info["TemplateUrl"]; ok {
+		d.Set("template_url", v)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", input)
+	if _, err := conn.UpdateProvisioningArtifact(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := decodeServiceCatalogProvisioningArtifactID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioning Artifact: %s", d.Id())
+	_, err = conn.DeleteProvisioningArtifact(&servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeServiceCatalogProvisioningArtifactID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected PRODUCT-ID:PROVISIONING-ARTIFACT-ID", id)
+	}
+	return parts[0], parts[1], nil
+}
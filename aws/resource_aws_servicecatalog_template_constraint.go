@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogTemplateConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTemplateConstraintCreate,
+		Read:   resourceAwsServiceCatalogTemplateConstraintRead,
+		Update: resourceAwsServiceCatalogTemplateConstraintUpdate,
+		Delete: resourceAwsServiceCatalogTemplateConstraintDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: serviceCatalogConstraintSchema(map[string]*schema.Schema{
+			"rules": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		}),
+	}
+}
+
+type serviceCatalogTemplateConstraintParameters struct {
+	Rules json.RawMessage `json:"Rules"`
+}
+
+func resourceAwsServiceCatalogTemplateConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	params, err := json.Marshal(&serviceCatalogTemplateConstraintParameters{
+		Rules: json.RawMessage(d.Get("rules").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error building Service Catalog Template Constraint parameters: %s", err)
+	}
+
+	if err := createServiceCatalogConstraint(conn, d, "TEMPLATE", string(params)); err != nil {
+		return err
+	}
+
+	return resourceAwsServiceCatalogTemplateConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTemplateConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	params, err := readServiceCatalogConstraint(conn, d)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return nil
+	}
+
+	var p serviceCatalogTemplateConstraintParameters
+	if err := json.Unmarshal([]byte(aws.StringValue(params)), &p); err != nil {
+		return fmt.Errorf("error parsing Service Catalog Template Constraint (%s) parameters: %s", d.Id(), err)
+	}
+	d.Set("rules", string(p.Rules))
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTemplateConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	if err := updateServiceCatalogConstraint(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsServiceCatalogTemplateConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTemplateConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteServiceCatalogConstraint(meta.(*AWSClient).scconn, d)
+}
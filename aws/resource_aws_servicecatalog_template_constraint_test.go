@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSServiceCatalogTemplateConstraint_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_template_constraint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTemplateConstraintConfig(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules"),
+					resource.TestCheckResourceAttr(resourceName, "type", "TEMPLATE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API may return the rules document with different formatting
+				ImportStateVerifyIgnore: []string{"rules"},
+			},
+			{
+				Config: testAccAWSServiceCatalogTemplateConstraintConfig(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSServiceCatalogTemplateConstraintConfig(rName, description string) string {
+	return testAccAWSServiceCatalogProductPortfolioAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_servicecatalog_template_constraint" "test" {
+  description  = %[1]q
+  portfolio_id = "${aws_servicecatalog_product_portfolio_association.test.portfolio_id}"
+  product_id   = "${aws_servicecatalog_product_portfolio_association.test.product_id}"
+
+  rules = <<EOF
+{
+  "Rule1": {
+    "Assertions": [
+      {
+        "Assert": {
+          "Fn::Contains": [["t2.micro", "t2.small"], {"Ref": "InstanceType"}]
+        },
+        "AssertDescription": "Instance type should be t2.micro or t2.small"
+      }
+    ]
+  }
+}
+EOF
+}
+`, description)
+}
@@ -365,37 +365,24 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 }
 
 func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k, _ := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
-			_, err := conn.UntagQueue(&sqs.UntagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				TagKeys:  keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+	return tagsUpdaterSQS(conn, d.Id()).updateResourceData(d)
+}
 
+func tagsUpdaterSQS(conn *sqs.SQS, queueUrl string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
 			_, err := conn.TagQueue(&sqs.TagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				Tags:     create,
+				QueueUrl: aws.String(queueUrl),
+				Tags:     aws.StringMap(tags.Map()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagQueue(&sqs.UntagQueueInput{
+				QueueUrl: aws.String(queueUrl),
+				TagKeys:  aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
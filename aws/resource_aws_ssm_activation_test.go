@@ -27,6 +27,12 @@ func TestAccAWSSSMActivation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_ssm_activation.foo", "activation_code"),
 				),
 			},
+			{
+				ResourceName:            "aws_ssm_activation.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_code"},
+			},
 		},
 	})
 }
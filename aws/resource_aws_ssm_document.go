@@ -27,6 +27,9 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Read:   resourceAwsSsmDocumentRead,
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -190,7 +193,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading SSM Document: %s", d.Id())
 
 	docInput := &ssm.DescribeDocumentInput{
-		Name: aws.String(d.Get("name").(string)),
+		Name: aws.String(d.Id()),
 	}
 
 	resp, err := ssmconn.DescribeDocument(docInput)
@@ -209,10 +212,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("description", doc.Description)
 	d.Set("schema_version", doc.SchemaVersion)
 
-	if _, ok := d.GetOk("document_type"); ok {
-		d.Set("document_type", doc.DocumentType)
-	}
-
+	d.Set("document_type", doc.DocumentType)
 	d.Set("document_format", doc.DocumentFormat)
 	d.Set("document_version", doc.DocumentVersion)
 	d.Set("hash", doc.Hash)
@@ -234,6 +234,17 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("status", doc.Status)
 
+	// The content of the default version is only available from GetDocument,
+	// in the format the document was created with.
+	contentResp, err := ssmconn.GetDocument(&ssm.GetDocumentInput{
+		Name:           doc.Name,
+		DocumentFormat: doc.DocumentFormat,
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error getting SSM document content: {{err}}", err)
+	}
+	d.Set("content", contentResp.Content)
+
 	gp, err := getDocumentPermissions(d, meta)
 
 	if err != nil {
@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -14,6 +16,19 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 		Create: resourceAwsSsmMaintenanceWindowTaskCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTaskRead,
 		Delete: resourceAwsSsmMaintenanceWindowTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW-ID/WINDOW-TASK-ID", d.Id())
+				}
+				windowID := idParts[0]
+				windowTaskID := idParts[1]
+				d.Set("window_id", windowID)
+				d.SetId(windowTaskID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -167,11 +182,18 @@ func expandAwsSsmTaskParameters(config []interface{}) map[string]*ssm.Maintenanc
 }
 
 func flattenAwsSsmTaskParameters(taskParameters map[string]*ssm.MaintenanceWindowTaskParameterValueExpression) []interface{} {
+	// Sort by name so the list ordering is stable between reads.
+	names := make([]string, 0, len(taskParameters))
+	for k := range taskParameters {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
 	result := make([]interface{}, 0, len(taskParameters))
-	for k, v := range taskParameters {
+	for _, k := range names {
 		taskParam := map[string]interface{}{
 			"name":   k,
-			"values": flattenStringList(v.Values),
+			"values": flattenStringList(taskParameters[k].Values),
 		}
 		result = append(result, taskParam)
 	}
@@ -219,50 +241,45 @@ func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta inte
 func resourceAwsSsmMaintenanceWindowTaskRead(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	params := &ssm.DescribeMaintenanceWindowTasksInput{
-		WindowId: aws.String(d.Get("window_id").(string)),
+	// GetMaintenanceWindowTask is used rather than DescribeMaintenanceWindowTasks
+	// as only the former returns the full set of task parameters.
+	params := &ssm.GetMaintenanceWindowTaskInput{
+		WindowId:     aws.String(d.Get("window_id").(string)),
+		WindowTaskId: aws.String(d.Id()),
 	}
 
-	resp, err := ssmconn.DescribeMaintenanceWindowTasks(params)
+	resp, err := ssmconn.GetMaintenanceWindowTask(params)
 	if err != nil {
+		if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+			log.Printf("[INFO] Maintenance Window Task not found. Removing from state")
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
-	found := false
-	for _, t := range resp.Tasks {
-		if *t.WindowTaskId == d.Id() {
-			found = true
-
-			d.Set("window_id", t.WindowId)
-			d.Set("max_concurrency", t.MaxConcurrency)
-			d.Set("max_errors", t.MaxErrors)
-			d.Set("task_type", t.Type)
-			d.Set("service_role_arn", t.ServiceRoleArn)
-			d.Set("task_arn", t.TaskArn)
-			d.Set("priority", t.Priority)
-
-			if t.LoggingInfo != nil {
-				if err := d.Set("logging_info", flattenAwsSsmMaintenanceWindowLoggingInfo(t.LoggingInfo)); err != nil {
-					return fmt.Errorf("[DEBUG] Error setting logging_info error: %#v", err)
-				}
-			}
-
-			if t.TaskParameters != nil {
-				if err := d.Set("task_parameters", flattenAwsSsmTaskParameters(t.TaskParameters)); err != nil {
-					return fmt.Errorf("[DEBUG] Error setting task_parameters error: %#v", err)
-				}
-			}
+	d.Set("window_id", resp.WindowId)
+	d.Set("max_concurrency", resp.MaxConcurrency)
+	d.Set("max_errors", resp.MaxErrors)
+	d.Set("task_type", resp.TaskType)
+	d.Set("service_role_arn", resp.ServiceRoleArn)
+	d.Set("task_arn", resp.TaskArn)
+	d.Set("priority", resp.Priority)
+
+	if resp.LoggingInfo != nil {
+		if err := d.Set("logging_info", flattenAwsSsmMaintenanceWindowLoggingInfo(resp.LoggingInfo)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting logging_info error: %#v", err)
+		}
+	}
 
-			if err := d.Set("targets", flattenAwsSsmTargets(t.Targets)); err != nil {
-				return fmt.Errorf("[DEBUG] Error setting targets error: %#v", err)
-			}
+	if resp.TaskParameters != nil {
+		if err := d.Set("task_parameters", flattenAwsSsmTaskParameters(resp.TaskParameters)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting task_parameters error: %#v", err)
 		}
 	}
 
-	if !found {
-		log.Printf("[INFO] Maintenance Window Target not found. Removing from state")
-		d.SetId("")
-		return nil
+	if err := d.Set("targets", flattenAwsSsmTargets(resp.Targets)); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting targets error: %#v", err)
 	}
 
 	return nil
@@ -59,6 +59,11 @@ func TestAccAWSSSMPatchBaseline_basic(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_patch_baseline.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
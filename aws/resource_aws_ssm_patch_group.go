@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -13,6 +15,18 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 		Create: resourceAwsSsmPatchGroupCreate,
 		Read:   resourceAwsSsmPatchGroupRead,
 		Delete: resourceAwsSsmPatchGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				baselineID, patchGroup, err := resourceAwsSsmPatchGroupParseImportId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("baseline_id", baselineID)
+				d.Set("patch_group", patchGroup)
+				d.SetId(patchGroup)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"baseline_id": {
@@ -51,19 +65,35 @@ func resourceAwsSsmPatchGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 	params := &ssm.DescribePatchGroupsInput{}
 
-	resp, err := ssmconn.DescribePatchGroups(params)
-	if err != nil {
-		return err
-	}
+	// A patch group may be registered with one baseline per operating system,
+	// so match on the baseline as well once it is known.
+	baselineID := d.Get("baseline_id").(string)
 
 	found := false
-	for _, t := range resp.Mappings {
-		if *t.PatchGroup == d.Id() {
+	for {
+		resp, err := ssmconn.DescribePatchGroups(params)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range resp.Mappings {
+			if *t.PatchGroup != d.Id() {
+				continue
+			}
+			if baselineID != "" && !ssmPatchBaselineIdsMatch(aws.StringValue(t.BaselineIdentity.BaselineId), baselineID) {
+				continue
+			}
+
 			found = true
 
 			d.Set("patch_group", t.PatchGroup)
 			d.Set("baseline_id", t.BaselineIdentity.BaselineId)
 		}
+
+		if found || resp.NextToken == nil {
+			break
+		}
+		params.NextToken = resp.NextToken
 	}
 
 	if !found {
@@ -93,3 +123,28 @@ func resourceAwsSsmPatchGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+// ssmPatchBaselineIdsMatch reports whether two baseline identifiers refer to
+// the same baseline, allowing for one of them to be given as an ARN.
+func ssmPatchBaselineIdsMatch(a, b string) bool {
+	return a == b || strings.HasSuffix(a, "/"+b) || strings.HasSuffix(b, "/"+a)
+}
+
+// resourceAwsSsmPatchGroupParseImportId splits an import ID of the form
+// BASELINE-ID/PATCH-GROUP. The baseline may be given as an ARN, in which case
+// the patch group starts after the "patchbaseline/pb-..." resource component.
+func resourceAwsSsmPatchGroupParseImportId(id string) (string, string, error) {
+	offset := 0
+	if strings.HasPrefix(id, "arn:") {
+		if i := strings.Index(id, ":patchbaseline/"); i != -1 {
+			offset = i + len(":patchbaseline/")
+		}
+	}
+
+	i := strings.Index(id[offset:], "/")
+	if i == -1 || offset+i == 0 || offset+i == len(id)-1 {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected BASELINE-ID/PATCH-GROUP", id)
+	}
+
+	return id[:offset+i], id[offset+i+1:], nil
+}
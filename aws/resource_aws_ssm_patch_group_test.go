@@ -24,10 +24,69 @@ func TestAccAWSSSMPatchGroup_basic(t *testing.T) {
 					testAccCheckAWSSSMPatchGroupExists("aws_ssm_patch_group.patchgroup"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_patch_group.patchgroup",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMPatchGroupImportStateIdFunc("aws_ssm_patch_group.patchgroup"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestResourceAwsSsmPatchGroupParseImportId(t *testing.T) {
+	cases := []struct {
+		Id                 string
+		ExpectedBaselineId string
+		ExpectedPatchGroup string
+		ExpectError        bool
+	}{
+		{
+			Id:                 "pb-0123456789abcdef0/patch-group",
+			ExpectedBaselineId: "pb-0123456789abcdef0",
+			ExpectedPatchGroup: "patch-group",
+		},
+		{
+			Id:                 "pb-0123456789abcdef0/web/prod",
+			ExpectedBaselineId: "pb-0123456789abcdef0",
+			ExpectedPatchGroup: "web/prod",
+		},
+		{
+			Id:                 "arn:aws:ssm:us-west-2:123456789012:patchbaseline/pb-0123456789abcdef0/patch-group",
+			ExpectedBaselineId: "arn:aws:ssm:us-west-2:123456789012:patchbaseline/pb-0123456789abcdef0",
+			ExpectedPatchGroup: "patch-group",
+		},
+		{
+			Id:          "pb-0123456789abcdef0",
+			ExpectError: true,
+		},
+		{
+			Id:          "/patch-group",
+			ExpectError: true,
+		},
+		{
+			Id:          "pb-0123456789abcdef0/",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		baselineId, patchGroup, err := resourceAwsSsmPatchGroupParseImportId(tc.Id)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected error for %q", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", tc.Id, err)
+		}
+		if baselineId != tc.ExpectedBaselineId || patchGroup != tc.ExpectedPatchGroup {
+			t.Fatalf("%q: expected %q/%q, got %q/%q", tc.Id, tc.ExpectedBaselineId, tc.ExpectedPatchGroup, baselineId, patchGroup)
+		}
+	}
+}
+
 func testAccCheckAWSSSMPatchGroupExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -101,3 +160,14 @@ resource "aws_ssm_patch_group" "patchgroup" {
 
 `, rName)
 }
+
+func testAccAWSSSMPatchGroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["baseline_id"], rs.Primary.Attributes["patch_group"]), nil
+	}
+}
@@ -17,6 +17,9 @@ func resourceAwsWafByteMatchSet() *schema.Resource {
 		Read:   resourceAwsWafByteMatchSetRead,
 		Update: resourceAwsWafByteMatchSetUpdate,
 		Delete: resourceAwsWafByteMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -40,6 +40,11 @@ func TestAccAWSWafGeoMatchSet_basic(t *testing.T) {
 						"aws_waf_geo_match_set.geo_match_set", "geo_match_constraint.1991628426.value", "CA"),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_geo_match_set.geo_match_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
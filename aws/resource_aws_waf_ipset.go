@@ -18,7 +18,7 @@ func resourceAwsWafIPSet() *schema.Resource {
 		Update: resourceAwsWafIPSetUpdate,
 		Delete: resourceAwsWafIPSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsWafImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,6 +34,11 @@ func TestAccAWSWafRateBasedRule_basic(t *testing.T) {
 						"aws_waf_rate_based_rule.wafrule", "metric_name", wafRuleName),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_rate_based_rule.wafrule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -16,6 +16,9 @@ func resourceAwsWafRegexMatchSet() *schema.Resource {
 		Read:   resourceAwsWafRegexMatchSetRead,
 		Update: resourceAwsWafRegexMatchSetUpdate,
 		Delete: resourceAwsWafRegexMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
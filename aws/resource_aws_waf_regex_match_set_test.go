@@ -126,6 +126,11 @@ func testAccAWSWafRegexMatchSet_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("aws_waf_regex_match_set.test", "regex_match_tuple.%d.text_transformation", &idx, "NONE"),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_regex_match_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,6 +15,9 @@ func resourceAwsWafRuleGroup() *schema.Resource {
 		Read:   resourceAwsWafRuleGroupRead,
 		Update: resourceAwsWafRuleGroupUpdate,
 		Delete: resourceAwsWafRuleGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -16,6 +16,9 @@ func resourceAwsWafSizeConstraintSet() *schema.Resource {
 		Read:   resourceAwsWafSizeConstraintSetRead,
 		Update: resourceAwsWafSizeConstraintSetUpdate,
 		Delete: resourceAwsWafSizeConstraintSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: wafSizeConstraintSetSchema(),
 	}
@@ -38,6 +38,11 @@ func TestAccAWSWafWebAcl_basic(t *testing.T) {
 						"aws_waf_web_acl.waf_acl", "metric_name", wafAclName),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_web_acl.waf_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
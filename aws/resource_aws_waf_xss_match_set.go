@@ -17,6 +17,9 @@ func resourceAwsWafXssMatchSet() *schema.Resource {
 		Read:   resourceAwsWafXssMatchSetRead,
 		Update: resourceAwsWafXssMatchSetUpdate,
 		Delete: resourceAwsWafXssMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
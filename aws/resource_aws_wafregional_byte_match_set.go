@@ -17,6 +17,9 @@ func resourceAwsWafRegionalByteMatchSet() *schema.Resource {
 		Read:   resourceAwsWafRegionalByteMatchSetRead,
 		Update: resourceAwsWafRegionalByteMatchSetUpdate,
 		Delete: resourceAwsWafRegionalByteMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
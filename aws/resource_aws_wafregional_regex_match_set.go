@@ -17,6 +17,9 @@ func resourceAwsWafRegionalRegexMatchSet() *schema.Resource {
 		Read:   resourceAwsWafRegionalRegexMatchSetRead,
 		Update: resourceAwsWafRegionalRegexMatchSetUpdate,
 		Delete: resourceAwsWafRegionalRegexMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
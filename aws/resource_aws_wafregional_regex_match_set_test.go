@@ -127,6 +127,11 @@ func testAccAWSWafRegionalRegexMatchSet_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("aws_wafregional_regex_match_set.test", "regex_match_tuple.%d.text_transformation", &idx, "NONE"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_regex_match_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
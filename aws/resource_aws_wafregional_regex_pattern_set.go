@@ -16,6 +16,9 @@ func resourceAwsWafRegionalRegexPatternSet() *schema.Resource {
 		Read:   resourceAwsWafRegionalRegexPatternSetRead,
 		Update: resourceAwsWafRegionalRegexPatternSetUpdate,
 		Delete: resourceAwsWafRegionalRegexPatternSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -18,6 +18,9 @@ func resourceAwsWafRegionalRule() *schema.Resource {
 		Read:   resourceAwsWafRegionalRuleRead,
 		Update: resourceAwsWafRegionalRuleUpdate,
 		Delete: resourceAwsWafRegionalRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
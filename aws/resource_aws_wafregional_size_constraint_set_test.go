@@ -45,6 +45,11 @@ func TestAccAWSWafRegionalSizeConstraintSet_basic(t *testing.T) {
 						"aws_wafregional_size_constraint_set.size_constraint_set", "size_constraints.2029852522.text_transformation", "NONE"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_size_constraint_set.size_constraint_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAwsWafRegionalWebAcl() *schema.Resource {
 		Read:   resourceAwsWafRegionalWebAclRead,
 		Update: resourceAwsWafRegionalWebAclUpdate,
 		Delete: resourceAwsWafRegionalWebAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
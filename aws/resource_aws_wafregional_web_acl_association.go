@@ -17,6 +17,9 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 		Create: resourceAwsWafRegionalWebAclAssociationCreate,
 		Read:   resourceAwsWafRegionalWebAclAssociationRead,
 		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafRegionalWebAclAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"web_acl_id": &schema.Schema{
@@ -95,8 +98,12 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 	if !found {
 		log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("web_acl_id", webAclId)
+	d.Set("resource_arn", resourceArn)
+
 	return nil
 }
 
@@ -120,6 +127,15 @@ func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceAwsWafRegionalWebAclAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || !wafResourceIdRegexp.MatchString(idParts[0]) || !strings.HasPrefix(idParts[1], "arn:") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected WEB-ACL-ID:RESOURCE-ARN", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsWafRegionalWebAclAssociationParseId(id string) (webAclId, resourceArn string) {
 	parts := strings.SplitN(id, ":", 2)
 	webAclId = parts[0]
@@ -12,6 +12,48 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafregional"
 )
 
+func TestResourceAwsWafRegionalWebAclAssociationImport(t *testing.T) {
+	cases := []struct {
+		Id          string
+		ExpectError bool
+	}{
+		{
+			Id:          "a1b2c3d4-5678-90ab-cdef-0123456789ab:arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			ExpectError: false,
+		},
+		{
+			Id:          "a1b2c3d4-5678-90ab-cdef-0123456789ab",
+			ExpectError: true,
+		},
+		{
+			Id:          "my-web-acl:arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			ExpectError: true,
+		},
+		{
+			Id:          "a1b2c3d4-5678-90ab-cdef-0123456789ab:my-lb",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceAwsWafRegionalWebAclAssociation().Data(nil)
+		d.SetId(tc.Id)
+		_, err := resourceAwsWafRegionalWebAclAssociationImport(d, nil)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("expected error for %q", tc.Id)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("unexpected error for %q: %s", tc.Id, err)
+		}
+	}
+
+	d := resourceAwsWafRegionalWebAcl().Data(nil)
+	d.SetId("not-a-waf-id")
+	if _, err := resourceAwsWafImport(d, nil); err == nil {
+		t.Fatalf("expected error for %q", d.Id())
+	}
+}
+
 func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -24,6 +66,11 @@ func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
 					testAccCheckWafRegionalWebAclAssociationExists("aws_wafregional_web_acl_association.foo"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_web_acl_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,391 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.ComputeValue,
+								workspaces.ComputeStandard,
+								workspaces.ComputePerformance,
+								workspaces.ComputePower,
+								workspaces.ComputeGraphics,
+							}, false),
+						},
+						"root_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"running_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  workspaces.RunningModeAlwaysOn,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.RunningModeAlwaysOn,
+								workspaces.RunningModeAutoStop,
+							}, false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								// The timeout is set in hour increments
+								value := v.(int)
+								if value <= 0 || value%60 != 0 {
+									errors = append(errors, fmt.Errorf("%q must be a positive multiple of 60, got: %d", k, value))
+								}
+								return
+							},
+						},
+						"user_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	req := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		req.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		req.Tags = tagsFromMapWorkspaces(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Workspace: %s", req)
+	resp, err := conn.CreateWorkspaces(&workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{req},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s", err)
+	}
+
+	if len(resp.FailedRequests) > 0 {
+		failed := resp.FailedRequests[0]
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s: %s", aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	if len(resp.PendingRequests) == 0 {
+		return fmt.Errorf("error creating WorkSpaces Workspace: empty response")
+	}
+
+	d.SetId(aws.StringValue(resp.PendingRequests[0].WorkspaceId))
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(), d.Timeout(schema.TimeoutCreate),
+		[]string{workspaces.WorkspaceStatePending},
+		[]string{workspaces.WorkspaceStateAvailable}); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	workspace, err := getWorkspacesWorkspace(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if workspace == nil || aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_properties: %s", err)
+	}
+
+	tags, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapWorkspaces(tags.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("workspace_properties") {
+		props := expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{}))
+
+		log.Printf("[DEBUG] Modifying WorkSpaces Workspace (%s) properties: %s", d.Id(), props)
+		_, err := conn.ModifyWorkspaceProperties(&workspaces.ModifyWorkspacePropertiesInput{
+			WorkspaceId:         aws.String(d.Id()),
+			WorkspaceProperties: props,
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying WorkSpaces Workspace (%s) properties: %s", d.Id(), err)
+		}
+
+		// An AUTO_STOP workspace may already have been stopped, which is a valid end state
+		if err := waitForWorkspacesWorkspaceState(conn, d.Id(), d.Timeout(schema.TimeoutUpdate),
+			[]string{workspaces.WorkspaceStateUpdating},
+			[]string{workspaces.WorkspaceStateAvailable, workspaces.WorkspaceStateStopped}); err != nil {
+			return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		if err := setTagsWorkspaces(conn, d); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Terminating WorkSpaces Workspace: %s", d.Id())
+	resp, err := conn.TerminateWorkspaces(&workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.FailedRequests) > 0 {
+		failed := resp.FailedRequests[0]
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s: %s", d.Id(), aws.StringValue(failed.ErrorCode), aws.StringValue(failed.ErrorMessage))
+	}
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(), d.Timeout(schema.TimeoutDelete),
+		[]string{
+			workspaces.WorkspaceStateAdminMaintenance,
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateMaintenance,
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateRebooting,
+			workspaces.WorkspaceStateRebuilding,
+			workspaces.WorkspaceStateStarting,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateStopping,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateTerminating,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateUpdating,
+		},
+		[]string{workspaces.WorkspaceStateTerminated}); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to be terminated: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getWorkspacesWorkspace(conn *workspaces.WorkSpaces, id string) (*workspaces.Workspace, error) {
+	resp, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Workspaces) == 0 {
+		return nil, nil
+	}
+
+	return resp.Workspaces[0], nil
+}
+
+func waitForWorkspacesWorkspaceState(conn *workspaces.WorkSpaces, id string, timeout time.Duration, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			workspace, err := getWorkspacesWorkspace(conn, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			// Terminated workspaces eventually disappear from the API
+			if workspace == nil {
+				return id, workspaces.WorkspaceStateTerminated, nil
+			}
+
+			state := aws.StringValue(workspace.State)
+			if state == workspaces.WorkspaceStateError {
+				return workspace, state, fmt.Errorf("%s: %s", aws.StringValue(workspace.ErrorCode), aws.StringValue(workspace.ErrorMessage))
+			}
+
+			return workspace, state, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func expandWorkspacesWorkspaceProperties(l []interface{}) *workspaces.WorkspaceProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	props := &workspaces.WorkspaceProperties{
+		RunningMode: aws.String(m["running_mode"].(string)),
+	}
+
+	if v, ok := m["compute_type_name"].(string); ok && v != "" {
+		props.ComputeTypeName = aws.String(v)
+	}
+
+	if v, ok := m["root_volume_size_gib"].(int); ok && v > 0 {
+		props.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v > 0 && m["running_mode"].(string) == workspaces.RunningModeAutoStop {
+		props.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["user_volume_size_gib"].(int); ok && v > 0 {
+		props.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	return props
+}
+
+func flattenWorkspacesWorkspaceProperties(props *workspaces.WorkspaceProperties) []interface{} {
+	if props == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"compute_type_name":                         aws.StringValue(props.ComputeTypeName),
+		"root_volume_size_gib":                      int(aws.Int64Value(props.RootVolumeSizeGib)),
+		"running_mode":                              aws.StringValue(props.RunningMode),
+		"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(props.RunningModeAutoStopTimeoutInMinutes)),
+		"user_volume_size_gib":                      int(aws.Int64Value(props.UserVolumeSizeGib)),
+	}
+
+	return []interface{}{m}
+}
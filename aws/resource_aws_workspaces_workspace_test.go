@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The WorkSpaces API available to the provider cannot register directories,
+// so these tests run against a directory already registered with WorkSpaces.
+func testAccPreCheckAWSWorkspaces(t *testing.T) {
+	if os.Getenv("WORKSPACES_DIRECTORY_ID") == "" {
+		t.Skip("Environment variable WORKSPACES_DIRECTORY_ID is not set")
+	}
+	if os.Getenv("WORKSPACES_USER_NAME") == "" {
+		t.Skip("Environment variable WORKSPACES_USER_NAME is not set")
+	}
+}
+
+func TestAccAWSWorkspacesWorkspace_basic(t *testing.T) {
+	var workspace workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWorkspacesWorkspaceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "bundle_id", "data.aws_workspaces_bundle.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", os.Getenv("WORKSPACES_DIRECTORY_ID")),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", workspaces.WorkspaceStateAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", os.Getenv("WORKSPACES_USER_NAME")),
+					resource.TestCheckResourceAttr(resourceName, "user_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAlwaysOn),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSWorkspacesWorkspace_workspaceProperties(t *testing.T) {
+	var workspace workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWorkspaces(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWorkspacesWorkspaceConfig_workspaceProperties(workspaces.RunningModeAutoStop, 60, "foo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAutoStop),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				Config: testAccAWSWorkspacesWorkspaceConfig_workspaceProperties(workspaces.RunningModeAutoStop, 120, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAutoStop),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWorkspacesWorkspaceExists(n string, v *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Workspace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		workspace, err := getWorkspacesWorkspace(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if workspace == nil {
+			return fmt.Errorf("WorkSpaces Workspace (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *workspace
+
+		return nil
+	}
+}
+
+func testAccCheckAWSWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		workspace, err := getWorkspacesWorkspace(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if workspace != nil && aws.StringValue(workspace.State) != workspaces.WorkspaceStateTerminated {
+			return fmt.Errorf("WorkSpaces Workspace (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSWorkspacesWorkspaceConfig_base() string {
+	return fmt.Sprintf(`
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-bh8rsxt14"
+}
+
+locals {
+  directory_id = "%s"
+  user_name    = "%s"
+}
+`, os.Getenv("WORKSPACES_DIRECTORY_ID"), os.Getenv("WORKSPACES_USER_NAME"))
+}
+
+func testAccAWSWorkspacesWorkspaceConfig_basic() string {
+	return testAccAWSWorkspacesWorkspaceConfig_base() + `
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = "${local.directory_id}"
+  user_name    = "${local.user_name}"
+}
+`
+}
+
+func testAccAWSWorkspacesWorkspaceConfig_workspaceProperties(runningMode string, autoStopTimeout int, tagName string) string {
+	return testAccAWSWorkspacesWorkspaceConfig_base() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = "${local.directory_id}"
+  user_name    = "${local.user_name}"
+
+  workspace_properties {
+    running_mode                              = %q
+    running_mode_auto_stop_timeout_in_minutes = %d
+  }
+
+  tags {
+    Name = %q
+  }
+}
+`, runningMode, autoStopTimeout, tagName)
+}
@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -12,92 +9,85 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, ignoreTags *tagIgnoreConfig) error {
+	bucket := d.Get("bucket").(string)
+	return tagsUpdaterS3(conn, bucket, ignoreTags, !d.IsNewResource()).updateResourceData(d)
+}
+
+// tagsUpdaterS3 returns the tagsUpdater for the tagging API of S3. The API
+// replaces the whole tag set of a bucket, so when preserveIgnored is set the
+// ignored tags already on the bucket are read and carried over.
+func tagsUpdaterS3(conn *s3.S3, bucket string, ignoreTags *tagIgnoreConfig, preserveIgnored bool) *tagsUpdater {
+	return &tagsUpdater{
+		replace: func(tags keyValueTags) error {
+			if preserveIgnored && ignoreTags != nil && (len(ignoreTags.Keys) > 0 || len(ignoreTags.KeyPrefixes) > 0) {
+				current, err := getTagSetS3(conn, bucket)
+				if err != nil {
+					return err
+				}
+				tags = newKeyValueTags(tags)
+				for k, v := range s3KeyValueTags(current) {
+					if !tagIgnoredAws(k) && ignoreTags.ignored(k) {
+						tags[k] = v
+					}
+				}
+			}
+
+			if len(tags) == 0 {
+				_, err := conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
+					Bucket: aws.String(bucket),
 				})
-			})
-			if err != nil {
 				return err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			req := &s3.PutBucketTaggingInput{
-				Bucket: aws.String(d.Get("bucket").(string)),
+
+			_, err := conn.PutBucketTagging(&s3.PutBucketTaggingInput{
+				Bucket: aws.String(bucket),
 				Tagging: &s3.Tagging{
-					TagSet: create,
+					TagSet: tags.s3Tags(),
 				},
-			}
-
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.PutBucketTagging(req)
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		retryable: tagsRetryableAwsCodes("NoSuchBucket", "OperationAborted"),
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapS3(create), remove
+	o, n := s3KeyValueTags(oldTags), s3KeyValueTags(newTags)
+	return o.Updated(n).s3Tags(), o.Removed(n).s3Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().s3Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
+	return s3KeyValueTags(ts).IgnoreAws().Map()
+}
+
+// s3KeyValueTags converts S3 tags to keyValueTags.
+func s3KeyValueTags(ts []*s3.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// s3Tags converts keyValueTags to S3 tags.
+func (tags keyValueTags) s3Tags() []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -125,13 +115,5 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Attributes shared by all Service Catalog constraint resources.
+func serviceCatalogConstraintSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["owner"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["portfolio_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["product_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return s
+}
+
+func createServiceCatalogConstraint(conn *servicecatalog.ServiceCatalog, d *schema.ResourceData, constraintType, parameters string) error {
+	portfolioID := d.Get("portfolio_id").(string)
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       aws.String(parameters),
+		PortfolioId:      aws.String(portfolioID),
+		ProductId:        aws.String(productID),
+		Type:             aws.String(constraintType),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog %s Constraint: %s", constraintType, input)
+	resp, err := conn.CreateConstraint(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog %s Constraint: %s", constraintType, err)
+	}
+
+	constraintID := aws.StringValue(resp.ConstraintDetail.ConstraintId)
+	d.SetId(fmt.Sprintf("%s:%s:%s", portfolioID, productID, constraintID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+				AcceptLanguage: aws.String("en"),
+				Id:             aws.String(constraintID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			status := aws.StringValue(resp.Status)
+			if status == servicecatalog.StatusFailed {
+				return resp, status, fmt.Errorf("Service Catalog Constraint (%s) creation failed", d.Id())
+			}
+			return resp, status, nil
+		},
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Constraint (%s) to become available: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// readServiceCatalogConstraint sets the shared attributes and returns the
+// constraint parameters, or nil if the constraint no longer exists.
+func readServiceCatalogConstraint(conn *servicecatalog.ServiceCatalog, d *schema.ResourceData) (*string, error) {
+	portfolioID, productID, constraintID, err := decodeServiceCatalogConstraintID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(constraintID),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Constraint %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading Service Catalog Constraint (%s): %s", d.Id(), err)
+	}
+
+	detail := resp.ConstraintDetail
+
+	d.Set("description", detail.Description)
+	d.Set("owner", detail.Owner)
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_id", productID)
+	d.Set("type", detail.Type)
+
+	return resp.ConstraintParameters, nil
+}
+
+func updateServiceCatalogConstraint(conn *servicecatalog.ServiceCatalog, d *schema.ResourceData) error {
+	_, _, constraintID, err := decodeServiceCatalogConstraintID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Description:    aws.String(d.Get("description").(string)),
+		Id:             aws.String(constraintID),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Constraint: %s", input)
+	if _, err := conn.UpdateConstraint(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Constraint (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func deleteServiceCatalogConstraint(conn *servicecatalog.ServiceCatalog, d *schema.ResourceData) error {
+	_, _, constraintID, err := decodeServiceCatalogConstraintID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Constraint: %s", d.Id())
+	_, err = conn.DeleteConstraint(&servicecatalog.DeleteConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(constraintID),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Service Catalog Constraint (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeServiceCatalogConstraintID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected PORTFOLIO-ID:PRODUCT-ID:CONSTRAINT-ID", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
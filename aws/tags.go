@@ -1,17 +1,13 @@
 package aws
 
 import (
-	"log"
-	"regexp"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -33,94 +29,39 @@ func tagsSchemaComputed() *schema.Schema {
 }
 
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+	return tagsUpdaterELBv2(conn, d.Id()).updateResourceData(d)
+}
+
+// tagsUpdaterELBv2 returns the tagsUpdater for the tagging API of ELBv2.
+func tagsUpdaterELBv2(conn *elbv2.ELBV2, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
 			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
+				ResourceArns: []*string{aws.String(arn)},
+				Tags:         tags.elbv2Tags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
+				ResourceArns: []*string{aws.String(arn)},
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	if d.HasChange("volume_tags") {
 		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
 			return err
 		}
 
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: volumeIds,
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: volumeIds,
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return tagsUpdaterEC2(conn, volumeIds...).updateTags(newKeyValueTags(oraw), newKeyValueTags(nraw))
 	}
 
 	return nil
@@ -129,151 +70,108 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+	return tagsUpdaterEC2(conn, aws.String(d.Id())).updateResourceData(d)
+}
+
+// tagsUpdaterEC2 returns the tagsUpdater for the tagging API of EC2. Tags are
+// applied to all of the given resources at once.
+func tagsUpdaterEC2(conn *ec2.EC2, ids ...*string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.CreateTags(&ec2.CreateTagsInput{
+				Resources: ids,
+				Tags:      tags.ec2Tags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
+				Resources: ids,
+				Tags:      tags.ec2Tags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		retryable: func(err error) bool {
+			ec2err, ok := err.(awserr.Error)
+			return ok && strings.Contains(ec2err.Code(), ".NotFound")
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMap(create), remove
+	o, n := ec2KeyValueTags(oldTags), ec2KeyValueTags(newTags)
+	return o.Updated(n).ec2Tags(), o.Removed(n).ec2Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().ec2Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
+	return ec2KeyValueTags(ts).IgnoreAws().Map()
+}
+
+// ec2KeyValueTags converts EC2 tags to keyValueTags.
+func ec2KeyValueTags(ts []*ec2.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+// ec2Tags converts keyValueTags to EC2 tags.
+func (tags keyValueTags) ec2Tags() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ec2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	return result
+}
 
-	return tagsFromMapELBv2(create), remove
+func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
+	o, n := elbv2KeyValueTags(oldTags), elbv2KeyValueTags(newTags)
+	return o.Updated(n).elbv2Tags(), o.Removed(n).elbv2Tags()
 }
 
 // tagsToMapELBv2 turns the list of tags into a map.
 func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
+	return elbv2KeyValueTags(ts).IgnoreAws().Map()
+}
+
+// tagsFromMapELBv2 returns the tags for the given map of data.
+func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
+	return newKeyValueTags(m).IgnoreAws().elbv2Tags()
+}
+
+// elbv2KeyValueTags converts ELBv2 tags to keyValueTags.
+func elbv2KeyValueTags(ts []*elbv2.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// tagsFromMapELBv2 returns the tags for the given map of data.
-func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
+// elbv2Tags converts keyValueTags to ELBv2 tags.
+func (tags keyValueTags) elbv2Tags() []*elbv2.Tag {
+	result := make([]*elbv2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elbv2.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -282,50 +180,22 @@ func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
 // tagIgnored compares a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
 
 // and for ELBv2 as well
 func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
 
 // tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
 func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-	return result
+	return dynamodbKeyValueTags(ts).Map()
 }
 
 // tagsFromMapDynamoDb returns the tags for a given map
 func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
-	result := make([]*dynamodb.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dynamodb.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		result = append(result, t)
-	}
-	return result
+	return newKeyValueTags(m).dynamodbTags()
 }
 
 // setTagsDynamoDb is a helper to set the tags for a dynamoDB resource
@@ -333,71 +203,57 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	arn := d.Get("arn").(string)
-	oraw, nraw := d.GetChange("tags")
-	o := oraw.(map[string]interface{})
-	n := nraw.(map[string]interface{})
-	create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
-
-	// Set tags
-	if len(remove) > 0 {
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
+	return tagsUpdaterDynamoDb(conn, d.Get("arn").(string)).updateResourceData(d)
+}
+
+// tagsUpdaterDynamoDb returns the tagsUpdater for the tagging API of DynamoDB.
+func tagsUpdaterDynamoDb(conn *dynamodb.DynamoDB, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagResource(&dynamodb.TagResourceInput{
 				ResourceArn: aws.String(arn),
-				TagKeys:     remove,
+				Tags:        tags.dynamodbTags(),
 			})
-			if err != nil {
-				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if err != nil {
 			return err
-		}
-	}
-	if len(create) > 0 {
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.TagResource(&dynamodb.TagResourceInput{
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
 				ResourceArn: aws.String(arn),
-				Tags:        create,
+				TagKeys:     aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if err != nil {
 			return err
-		}
+		},
+		retryable: tagsRetryableAwsCodes(dynamodb.ErrCodeResourceNotFoundException),
 	}
-
-	return nil
 }
 
 // diffTagsDynamoDb takes a local set of dynamodb tags and the ones found remotely
 // and returns the set of tags that must be created as a map, and returns a list of tag keys
 // that must be destroyed.
 func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*string) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+	o, n := dynamodbKeyValueTags(oldTags), dynamodbKeyValueTags(newTags)
+	return o.Updated(n).dynamodbTags(), aws.StringSlice(o.Removed(n).Keys())
+}
+
+// dynamodbKeyValueTags converts DynamoDB tags to keyValueTags.
+func dynamodbKeyValueTags(ts []*dynamodb.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	var remove []*string
-	for _, t := range oldTags {
-		// Verify the old tag is not a tag we're currently attempting to create
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t.Key)
-		}
+	return tags
+}
+
+// dynamodbTags converts keyValueTags to DynamoDB tags.
+func (tags keyValueTags) dynamodbTags() []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
-	return tagsFromMapDynamoDb(create), remove
+
+	return result
 }
@@ -1,88 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// setTagsACM is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
 func setTagsACM(conn *acm.ACM, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsACM(tagsFromMapACM(o), tagsFromMapACM(n))
+	return tagsUpdaterACM(conn, d.Get("arn").(string)).updateResourceData(d)
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			input := acm.RemoveTagsFromCertificateInput{
-				CertificateArn: aws.String(d.Get("arn").(string)),
-				Tags:           remove,
-			}
-			log.Printf("[DEBUG] Removing ACM tags: %s", input)
-			_, err := conn.RemoveTagsFromCertificate(&input)
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			input := acm.AddTagsToCertificateInput{
-				CertificateArn: aws.String(d.Get("arn").(string)),
-				Tags:           create,
-			}
-			log.Printf("[DEBUG] Adding ACM tags: %s", input)
-			_, err := conn.AddTagsToCertificate(&input)
-			if err != nil {
-				return err
-			}
-		}
+// tagsUpdaterACM returns the tagsUpdater for the tagging API of ACM.
+func tagsUpdaterACM(conn *acm.ACM, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTagsToCertificate(&acm.AddTagsToCertificateInput{
+				CertificateArn: aws.String(arn),
+				Tags:           tags.acmTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromCertificate(&acm.RemoveTagsFromCertificateInput{
+				CertificateArn: aws.String(arn),
+				Tags:           tags.acmTags(),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsACM(oldTags, newTags []*acm.Tag) ([]*acm.Tag, []*acm.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := acmKeyValueTags(oldTags), acmKeyValueTags(newTags)
+	return o.Updated(n).acmTags(), o.Removed(n).acmTags()
+}
 
-	// Build the list of what to remove
-	var remove []*acm.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapACM(m map[string]interface{}) []*acm.Tag {
+	return newKeyValueTags(m).IgnoreAws().acmTags()
+}
 
-	return tagsFromMapACM(create), remove
+// tagsToMap turns the list of tags into a map.
+func tagsToMapACM(ts []*acm.Tag) map[string]string {
+	return acmKeyValueTags(ts).IgnoreAws().Map()
 }
 
-func tagsFromMapACM(m map[string]interface{}) []*acm.Tag {
-	result := []*acm.Tag{}
-	for k, v := range m {
-		result = append(result, &acm.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+// acmKeyValueTags converts ACM tags to keyValueTags.
+func acmKeyValueTags(ts []*acm.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func tagsToMapACM(ts []*acm.Tag) map[string]string {
-	result := map[string]string{}
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
+// acmTags converts keyValueTags to ACM tags.
+func (tags keyValueTags) acmTags() []*acm.Tag {
+	result := make([]*acm.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &acm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -91,13 +76,5 @@ func tagsToMapACM(ts []*acm.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredACM(t *acm.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -1,52 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// setTagsACMPCA is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsACMPCA(conn *acmpca.ACMPCA, d *schema.ResourceData) error {
+	return tagsUpdaterACMPCA(conn, d.Id()).updateResourceData(d)
+}
+
+// tagsUpdaterACMPCA returns the tagsUpdater for the tagging API of ACM PCA.
+func tagsUpdaterACMPCA(conn *acmpca.ACMPCA, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagCertificateAuthority(&acmpca.TagCertificateAuthorityInput{
+				CertificateAuthorityArn: aws.String(arn),
+				Tags:                    tags.acmpcaTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagCertificateAuthority(&acmpca.UntagCertificateAuthorityInput{
+				CertificateAuthorityArn: aws.String(arn),
+				Tags:                    tags.acmpcaTags(),
+			})
+			return err
+		},
+	}
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsACMPCA(oldTags, newTags []*acmpca.Tag) ([]*acmpca.Tag, []*acmpca.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := acmpcaKeyValueTags(oldTags), acmpcaKeyValueTags(newTags)
+	return o.Updated(n).acmpcaTags(), o.Removed(n).acmpcaTags()
+}
 
-	// Build the list of what to remove
-	var remove []*acmpca.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapACMPCA(m map[string]interface{}) []*acmpca.Tag {
+	return newKeyValueTags(m).IgnoreAws().acmpcaTags()
+}
 
-	return tagsFromMapACMPCA(create), remove
+// tagsToMap turns the list of tags into a map.
+func tagsToMapACMPCA(ts []*acmpca.Tag) map[string]string {
+	return acmpcaKeyValueTags(ts).IgnoreAws().Map()
 }
 
-func tagsFromMapACMPCA(m map[string]interface{}) []*acmpca.Tag {
-	result := []*acmpca.Tag{}
-	for k, v := range m {
-		result = append(result, &acmpca.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+// acmpcaKeyValueTags converts ACM PCA tags to keyValueTags.
+func acmpcaKeyValueTags(ts []*acmpca.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func tagsToMapACMPCA(ts []*acmpca.Tag) map[string]string {
-	result := map[string]string{}
-	for _, t := range ts {
-		result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+// acmpcaTags converts keyValueTags to ACM PCA tags.
+func (tags keyValueTags) acmpcaTags() []*acmpca.Tag {
+	result := make([]*acmpca.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &acmpca.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -55,13 +76,5 @@ func tagsToMapACMPCA(ts []*acmpca.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredACMPCA(t *acmpca.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		if r, _ := regexp.MatchString(v, aws.StringValue(t.Key)); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
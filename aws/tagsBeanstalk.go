@@ -12,46 +12,49 @@ import (
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*string) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := beanstalkKeyValueTags(oldTags), beanstalkKeyValueTags(newTags)
+	return o.Updated(n).beanstalkTags(), aws.StringSlice(o.Removed(n).Keys())
+}
 
-	// Build the list of what to remove
-	var remove []*string
-	for _, t := range oldTags {
-		if _, ok := create[*t.Key]; !ok {
-			// Delete it!
-			remove = append(remove, t.Key)
-		}
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
+	return newKeyValueTags(m).ignoreBeanstalk().beanstalkTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
+	return beanstalkKeyValueTags(ts).ignoreBeanstalk().Map()
+}
+
+// beanstalkKeyValueTags converts Elastic Beanstalk tags to keyValueTags.
+func beanstalkKeyValueTags(ts []*elasticbeanstalk.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return tagsFromMapBeanstalk(create), remove
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
-	var result []*elasticbeanstalk.Tag
-	for k, v := range m {
-		t := &elasticbeanstalk.Tag{
+// beanstalkTags converts keyValueTags to Elastic Beanstalk tags.
+func (tags keyValueTags) beanstalkTags() []*elasticbeanstalk.Tag {
+	result := make([]*elasticbeanstalk.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticbeanstalk.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredBeanstalk(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
-			result[*t.Key] = *t.Value
+// ignoreBeanstalk returns the tags without those managed by Elastic Beanstalk.
+func (tags keyValueTags) ignoreBeanstalk() keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if !tagIgnoredBeanstalk(&elasticbeanstalk.Tag{Key: aws.String(k), Value: aws.String(v)}) {
+			result[k] = v
 		}
 	}
 
@@ -61,7 +64,11 @@ func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:", "^elasticbeanstalk:", "Name"}
+	if tagIgnoredAws(aws.StringValue(t.Key)) {
+		return true
+	}
+
+	filter := []string{"^elasticbeanstalk:", "Name"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
 		if r, _ := regexp.MatchString(v, *t.Key); r == true {
@@ -1,97 +1,75 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
+	return tagsUpdaterCloudFront(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
+// tagsUpdaterCloudFront returns the tagsUpdater for the tagging API of CloudFront.
+func tagsUpdaterCloudFront(conn *cloudfront.CloudFront, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagResource(&cloudfront.TagResourceInput{
 				Resource: aws.String(arn),
-				TagKeys: &cloudfront.TagKeys{
-					Items: k,
+				Tags: &cloudfront.Tags{
+					Items: tags.cloudfrontTags(),
 				},
 			})
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.TagResource(&cloudfront.TagResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
 				Resource: aws.String(arn),
-				Tags: &cloudfront.Tags{
-					Items: create,
+				TagKeys: &cloudfront.TagKeys{
+					Items: aws.StringSlice(tags.Keys()),
 				},
 			})
-			if err != nil {
-				return err
-			}
-		}
-
+			return err
+		},
 	}
-
-	return nil
 }
+
 func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags.Items {
-		create[*t.Key] = *t.Value
-	}
+	o, n := cloudfrontKeyValueTags(oldTags), cloudfrontKeyValueTags(newTags)
+	return o.Updated(n).cloudfrontTags(), o.Removed(n).cloudfrontTags()
+}
 
-	// Build the list of what to remove
-	var remove []*cloudfront.Tag
-	for _, t := range oldTags.Items {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
+	return &cloudfront.Tags{
+		Items: newKeyValueTags(m).IgnoreAws().cloudfrontTags(),
 	}
+}
 
-	createTags := tagsFromMapCloudFront(create)
-	return createTags.Items, remove
+func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
+	return cloudfrontKeyValueTags(ts).IgnoreAws().Map()
 }
 
-func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
-	result := make([]*cloudfront.Tag, 0, len(m))
-	for k, v := range m {
-		result = append(result, &cloudfront.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+// cloudfrontKeyValueTags converts CloudFront tags to keyValueTags.
+func cloudfrontKeyValueTags(ts *cloudfront.Tags) keyValueTags {
+	tags := make(keyValueTags)
+	if ts == nil {
+		return tags
 	}
-
-	tags := &cloudfront.Tags{
-		Items: result,
+	for _, t := range ts.Items {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
 	return tags
 }
 
-func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
-	result := make(map[string]string)
-
-	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
+// cloudfrontTags converts keyValueTags to CloudFront tags.
+func (tags keyValueTags) cloudfrontTags() []*cloudfront.Tag {
+	result := make([]*cloudfront.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &cloudfront.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
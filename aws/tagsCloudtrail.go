@@ -1,97 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsCloudtrail is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudtrail(tagsFromMapCloudtrail(o), tagsFromMapCloudtrail(n))
+	return tagsUpdaterCloudtrail(conn, d.Get("arn").(string)).updateResourceData(d)
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			input := cloudtrail.RemoveTagsInput{
-				ResourceId: aws.String(d.Get("arn").(string)),
-				TagsList:   remove,
-			}
-			log.Printf("[DEBUG] Removing CloudTrail tags: %s", input)
-			_, err := conn.RemoveTags(&input)
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			input := cloudtrail.AddTagsInput{
-				ResourceId: aws.String(d.Get("arn").(string)),
-				TagsList:   create,
-			}
-			log.Printf("[DEBUG] Adding CloudTrail tags: %s", input)
-			_, err := conn.AddTags(&input)
-			if err != nil {
-				return err
-			}
-		}
+// tagsUpdaterCloudtrail returns the tagsUpdater for the tagging API of CloudTrail.
+func tagsUpdaterCloudtrail(conn *cloudtrail.CloudTrail, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTags(&cloudtrail.AddTagsInput{
+				ResourceId: aws.String(arn),
+				TagsList:   tags.cloudtrailTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTags(&cloudtrail.RemoveTagsInput{
+				ResourceId: aws.String(arn),
+				TagsList:   tags.cloudtrailTags(),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsCloudtrail(oldTags, newTags []*cloudtrail.Tag) ([]*cloudtrail.Tag, []*cloudtrail.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*cloudtrail.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapCloudtrail(create), remove
+	o, n := cloudtrailKeyValueTags(oldTags), cloudtrailKeyValueTags(newTags)
+	return o.Updated(n).cloudtrailTags(), o.Removed(n).cloudtrailTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
-	var result []*cloudtrail.Tag
-	for k, v := range m {
-		t := &cloudtrail.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredCloudtrail(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().cloudtrailTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
-	result := make(map[string]string)
+	return cloudtrailKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// cloudtrailKeyValueTags converts CloudTrail tags to keyValueTags.
+func cloudtrailKeyValueTags(ts []*cloudtrail.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredCloudtrail(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// cloudtrailTags converts keyValueTags to CloudTrail tags.
+func (tags keyValueTags) cloudtrailTags() []*cloudtrail.Tag {
+	result := make([]*cloudtrail.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &cloudtrail.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -100,13 +76,5 @@ func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredCloudtrail(t *cloudtrail.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
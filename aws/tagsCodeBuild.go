@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 )
@@ -12,41 +9,38 @@ import (
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsCodeBuild(oldTags, newTags []*codebuild.Tag) ([]*codebuild.Tag, []*codebuild.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := codebuildKeyValueTags(oldTags), codebuildKeyValueTags(newTags)
+	return o.Updated(n).codebuildTags(), o.Removed(n).codebuildTags()
+}
 
-	// Build the list of what to remove
-	var remove []*codebuild.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
+	return newKeyValueTags(m).IgnoreAws().codebuildTags()
+}
 
-	return tagsFromMapCodeBuild(create), remove
+// tagsToMap turns the list of tags into a map.
+func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
+	return codebuildKeyValueTags(ts).IgnoreAws().Map()
 }
 
-func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
-	result := []*codebuild.Tag{}
-	for k, v := range m {
-		result = append(result, &codebuild.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+// codebuildKeyValueTags converts CodeBuild tags to keyValueTags.
+func codebuildKeyValueTags(ts []*codebuild.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
-	result := map[string]string{}
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
+// codebuildTags converts keyValueTags to CodeBuild tags.
+func (tags keyValueTags) codebuildTags() []*codebuild.Tag {
+	result := make([]*codebuild.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &codebuild.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -55,13 +49,5 @@ func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredCodeBuild(t *codebuild.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
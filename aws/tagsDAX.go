@@ -1,100 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsDax is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDax(conn *dax.DAX, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDax(tagsFromMapDax(o), tagsFromMapDax(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	return tagsUpdaterDax(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.UntagResource(&dax.UntagResourceInput{
+// tagsUpdaterDax returns the tagsUpdater for the tagging API of DAX.
+func tagsUpdaterDax(conn *dax.DAX, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagResource(&dax.TagResourceInput{
 				ResourceName: aws.String(arn),
-				TagKeys:      k,
+				Tags:         tags.daxTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&dax.TagResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&dax.UntagResourceInput{
 				ResourceName: aws.String(arn),
-				Tags:         create,
+				TagKeys:      aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsDax(oldTags, newTags []*dax.Tag) ([]*dax.Tag, []*dax.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*dax.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapDax(create), remove
+	o, n := daxKeyValueTags(oldTags), daxKeyValueTags(newTags)
+	return o.Updated(n).daxTags(), o.Removed(n).daxTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapDax(m map[string]interface{}) []*dax.Tag {
-	result := make([]*dax.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dax.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDax(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().daxTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapDax(ts []*dax.Tag) map[string]string {
-	result := make(map[string]string)
+	return daxKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// daxKeyValueTags converts DAX tags to keyValueTags.
+func daxKeyValueTags(ts []*dax.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredDax(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// daxTags converts keyValueTags to DAX tags.
+func (tags keyValueTags) daxTags() []*dax.Tag {
+	result := make([]*dax.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dax.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -103,13 +76,5 @@ func tagsToMapDax(ts []*dax.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredDax(t *dax.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -1,100 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsDS is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDS(tagsFromMapDS(o), tagsFromMapDS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	return tagsUpdaterDS(conn, resourceId).updateResourceData(d)
+}
 
-			_, err := conn.RemoveTagsFromResource(&directoryservice.RemoveTagsFromResourceInput{
+// tagsUpdaterDS returns the tagsUpdater for the tagging API of Directory Service.
+func tagsUpdaterDS(conn *directoryservice.DirectoryService, resourceId string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTagsToResource(&directoryservice.AddTagsToResourceInput{
 				ResourceId: aws.String(resourceId),
-				TagKeys:    k,
+				Tags:       tags.dsTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&directoryservice.AddTagsToResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&directoryservice.RemoveTagsFromResourceInput{
 				ResourceId: aws.String(resourceId),
-				Tags:       create,
+				TagKeys:    aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsDS(oldTags, newTags []*directoryservice.Tag) ([]*directoryservice.Tag, []*directoryservice.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*directoryservice.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapDS(create), remove
+	o, n := dsKeyValueTags(oldTags), dsKeyValueTags(newTags)
+	return o.Updated(n).dsTags(), o.Removed(n).dsTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
-	result := make([]*directoryservice.Tag, 0, len(m))
-	for k, v := range m {
-		t := &directoryservice.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().dsTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
-	result := make(map[string]string)
+	return dsKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// dsKeyValueTags converts Directory Service tags to keyValueTags.
+func dsKeyValueTags(ts []*directoryservice.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredDS(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// dsTags converts keyValueTags to Directory Service tags.
+func (tags keyValueTags) dsTags() []*directoryservice.Tag {
+	result := make([]*directoryservice.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &directoryservice.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -103,13 +76,5 @@ func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredDS(t *directoryservice.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
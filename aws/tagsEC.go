@@ -1,100 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsEC is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEC(tagsFromMapEC(o), tagsFromMapEC(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	return tagsUpdaterEC(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.RemoveTagsFromResource(&elasticache.RemoveTagsFromResourceInput{
+// tagsUpdaterEC returns the tagsUpdater for the tagging API of ElastiCache.
+func tagsUpdaterEC(conn *elasticache.ElastiCache, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTagsToResource(&elasticache.AddTagsToResourceInput{
 				ResourceName: aws.String(arn),
-				TagKeys:      k,
+				Tags:         tags.elasticacheTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.AddTagsToResource(&elasticache.AddTagsToResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&elasticache.RemoveTagsFromResourceInput{
 				ResourceName: aws.String(arn),
-				Tags:         create,
+				TagKeys:      aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsEC(oldTags, newTags []*elasticache.Tag) ([]*elasticache.Tag, []*elasticache.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elasticache.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapEC(create), remove
+	o, n := elasticacheKeyValueTags(oldTags), elasticacheKeyValueTags(newTags)
+	return o.Updated(n).elasticacheTags(), o.Removed(n).elasticacheTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
-	result := make([]*elasticache.Tag, 0, len(m))
-	for k, v := range m {
-		t := &elasticache.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredEC(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().elasticacheTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
-	result := make(map[string]string)
+	return elasticacheKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// elasticacheKeyValueTags converts ElastiCache tags to keyValueTags.
+func elasticacheKeyValueTags(ts []*elasticache.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredEC(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// elasticacheTags converts keyValueTags to ElastiCache tags.
+func (tags keyValueTags) elasticacheTags() []*elasticache.Tag {
+	result := make([]*elasticache.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticache.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -103,13 +76,5 @@ func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredEC(t *elasticache.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -1,99 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsEFS is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsEFS(conn *efs.EFS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEFS(tagsFromMapEFS(o), tagsFromMapEFS(n))
+	return tagsUpdaterEFS(conn, d.Id()).updateResourceData(d)
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
-			_, err := conn.DeleteTags(&efs.DeleteTagsInput{
-				FileSystemId: aws.String(d.Id()),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+// tagsUpdaterEFS returns the tagsUpdater for the tagging API of EFS.
+func tagsUpdaterEFS(conn *efs.EFS, fileSystemId string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
 			_, err := conn.CreateTags(&efs.CreateTagsInput{
-				FileSystemId: aws.String(d.Id()),
-				Tags:         create,
+				FileSystemId: aws.String(fileSystemId),
+				Tags:         tags.efsTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.DeleteTags(&efs.DeleteTagsInput{
+				FileSystemId: aws.String(fileSystemId),
+				TagKeys:      aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsEFS(oldTags, newTags []*efs.Tag) ([]*efs.Tag, []*efs.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*efs.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapEFS(create), remove
+	o, n := efsKeyValueTags(oldTags), efsKeyValueTags(newTags)
+	return o.Updated(n).efsTags(), o.Removed(n).efsTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapEFS(m map[string]interface{}) []*efs.Tag {
-	var result []*efs.Tag
-	for k, v := range m {
-		t := &efs.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredEFS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().efsTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapEFS(ts []*efs.Tag) map[string]string {
-	result := make(map[string]string)
+	return efsKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// efsKeyValueTags converts EFS tags to keyValueTags.
+func efsKeyValueTags(ts []*efs.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredEFS(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// efsTags converts keyValueTags to EFS tags.
+func (tags keyValueTags) efsTags() []*efs.Tag {
+	result := make([]*efs.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &efs.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -102,13 +76,5 @@ func tagsToMapEFS(ts []*efs.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredEFS(t *efs.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -1,100 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsKMS is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKMS(tagsFromMapKMS(o), tagsFromMapKMS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.TagKey
-			}
+	return tagsUpdaterKMS(conn, keyId).updateResourceData(d)
+}
 
-			_, err := conn.UntagResource(&kms.UntagResourceInput{
-				KeyId:   aws.String(keyId),
-				TagKeys: k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+// tagsUpdaterKMS returns the tagsUpdater for the tagging API of KMS.
+func tagsUpdaterKMS(conn *kms.KMS, keyId string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
 			_, err := conn.TagResource(&kms.TagResourceInput{
 				KeyId: aws.String(keyId),
-				Tags:  create,
+				Tags:  tags.kmsTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&kms.UntagResourceInput{
+				KeyId:   aws.String(keyId),
+				TagKeys: aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsKMS(oldTags, newTags []*kms.Tag) ([]*kms.Tag, []*kms.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
-	}
-
-	// Build the list of what to remove
-	var remove []*kms.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.TagKey)]
-		if !ok || old != aws.StringValue(t.TagValue) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapKMS(create), remove
+	o, n := kmsKeyValueTags(oldTags), kmsKeyValueTags(newTags)
+	return o.Updated(n).kmsTags(), o.Removed(n).kmsTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
-	result := make([]*kms.Tag, 0, len(m))
-	for k, v := range m {
-		t := &kms.Tag{
-			TagKey:   aws.String(k),
-			TagValue: aws.String(v.(string)),
-		}
-		if !tagIgnoredKMS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().kmsTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapKMS(ts []*kms.Tag) map[string]string {
-	result := make(map[string]string)
+	return kmsKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// kmsKeyValueTags converts KMS tags to keyValueTags.
+func kmsKeyValueTags(ts []*kms.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredKMS(t) {
-			result[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
-		}
+		tags[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
+	}
+
+	return tags
+}
+
+// kmsTags converts keyValueTags to KMS tags.
+func (tags keyValueTags) kmsTags() []*kms.Tag {
+	result := make([]*kms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &kms.Tag{
+			TagKey:   aws.String(k),
+			TagValue: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -103,13 +76,5 @@ func tagsToMapKMS(ts []*kms.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredKMS(t *kms.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.TagKey)
-		if r, _ := regexp.MatchString(v, *t.TagKey); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.TagKey, *t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.TagKey))
 }
@@ -1,8 +1,6 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,40 +9,25 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsOpsworks(conn *opsworks.OpsWorks, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsGeneric(o, n)
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	return tagsUpdaterOpsworks(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.UntagResource(&opsworks.UntagResourceInput{
+// tagsUpdaterOpsworks returns the tagsUpdater for the tagging API of OpsWorks.
+func tagsUpdaterOpsworks(conn *opsworks.OpsWorks, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagResource(&opsworks.TagResourceInput{
 				ResourceArn: aws.String(arn),
-				TagKeys:     keys,
+				Tags:        aws.StringMap(tags.Map()),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-
-			_, err := conn.TagResource(&opsworks.TagResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&opsworks.UntagResourceInput{
 				ResourceArn: aws.String(arn),
-				Tags:        create,
+				TagKeys:     aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
@@ -2,100 +2,74 @@ package aws
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsRDS is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	return tagsUpdaterRDS(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
+// tagsUpdaterRDS returns the tagsUpdater for the tagging API of RDS.
+func tagsUpdaterRDS(conn *rds.RDS, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTagsToResource(&rds.AddTagsToResourceInput{
 				ResourceName: aws.String(arn),
-				TagKeys:      k,
+				Tags:         tags.rdsTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&rds.AddTagsToResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
 				ResourceName: aws.String(arn),
-				Tags:         create,
+				TagKeys:      aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*rds.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapRDS(create), remove
+	o, n := rdsKeyValueTags(oldTags), rdsKeyValueTags(newTags)
+	return o.Updated(n).rdsTags(), o.Removed(n).rdsTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
-	result := make([]*rds.Tag, 0, len(m))
-	for k, v := range m {
-		t := &rds.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRDS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().rdsTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapRDS(ts []*rds.Tag) map[string]string {
-	result := make(map[string]string)
+	return rdsKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// rdsKeyValueTags converts RDS tags to keyValueTags.
+func rdsKeyValueTags(ts []*rds.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredRDS(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// rdsTags converts keyValueTags to RDS tags.
+func (tags keyValueTags) rdsTags() []*rds.Tag {
+	result := make([]*rds.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &rds.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -121,13 +95,5 @@ func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredRDS(t *rds.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
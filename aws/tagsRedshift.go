@@ -1,93 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// setTagsRedshift is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
 func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRedshift(tagsFromMapRedshift(o), tagsFromMapRedshift(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	return tagsUpdaterRedshift(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.DeleteTags(&redshift.DeleteTagsInput{
+// tagsUpdaterRedshift returns the tagsUpdater for the tagging API of Redshift.
+func tagsUpdaterRedshift(conn *redshift.Redshift, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.CreateTags(&redshift.CreateTagsInput{
 				ResourceName: aws.String(arn),
-				TagKeys:      k,
+				Tags:         tags.redshiftTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.CreateTags(&redshift.CreateTagsInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.DeleteTags(&redshift.DeleteTagsInput{
 				ResourceName: aws.String(arn),
-				Tags:         create,
+				TagKeys:      aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
+// diffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
 func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*redshift.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := redshiftKeyValueTags(oldTags), redshiftKeyValueTags(newTags)
+	return o.Updated(n).redshiftTags(), o.Removed(n).redshiftTags()
+}
 
-	// Build the list of what to remove
-	var remove []*redshift.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
+	return newKeyValueTags(m).IgnoreAws().redshiftTags()
+}
 
-	return tagsFromMapRedshift(create), remove
+// tagsToMap turns the list of tags into a map.
+func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
+	return redshiftKeyValueTags(ts).IgnoreAws().Map()
 }
 
-func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
-	result := make([]*redshift.Tag, 0, len(m))
-	for k, v := range m {
-		t := &redshift.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRedshift(t) {
-			result = append(result, t)
-		}
+// redshiftKeyValueTags converts Redshift tags to keyValueTags.
+func redshiftKeyValueTags(ts []*redshift.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredRedshift(t) {
-			result[*t.Key] = *t.Value
-		}
+// redshiftTags converts keyValueTags to Redshift tags.
+func (tags keyValueTags) redshiftTags() []*redshift.Tag {
+	result := make([]*redshift.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &redshift.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -96,13 +76,5 @@ func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredRedshift(t *redshift.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setTagsSagemaker is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsSagemaker(conn *sagemaker.SageMaker, d *schema.ResourceData, arn string) error {
+	return tagsUpdaterSagemaker(conn, arn).updateResourceData(d)
+}
+
+// tagsUpdaterSagemaker returns the tagsUpdater for the tagging API of SageMaker.
+func tagsUpdaterSagemaker(conn *sagemaker.SageMaker, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTags(&sagemaker.AddTagsInput{
+				ResourceArn: aws.String(arn),
+				Tags:        tags.sagemakerTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.DeleteTags(&sagemaker.DeleteTagsInput{
+				ResourceArn: aws.String(arn),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+	}
+}
+
+// listTagsSagemaker returns all tags of the resource with the given ARN.
+func listTagsSagemaker(conn *sagemaker.SageMaker, arn string) ([]*sagemaker.Tag, error) {
+	var tags []*sagemaker.Tag
+
+	input := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(arn),
+	}
+	for {
+		output, err := conn.ListTags(input)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, output.Tags...)
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return tags, nil
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapSagemaker(m map[string]interface{}) []*sagemaker.Tag {
+	return newKeyValueTags(m).IgnoreAws().sagemakerTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapSagemaker(ts []*sagemaker.Tag) map[string]string {
+	return sagemakerKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// sagemakerKeyValueTags converts SageMaker tags to keyValueTags.
+func sagemakerKeyValueTags(ts []*sagemaker.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// sagemakerTags converts keyValueTags to SageMaker tags.
+func (tags keyValueTags) sagemakerTags() []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
+}
@@ -1,59 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// setTagsSecretsManager is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsSecretsManager(conn *secretsmanager.SecretsManager, d *schema.ResourceData) error {
+	return tagsUpdaterSecretsManager(conn, d.Id()).updateResourceData(d)
+}
+
+// tagsUpdaterSecretsManager returns the tagsUpdater for the tagging API of Secrets Manager.
+func tagsUpdaterSecretsManager(conn *secretsmanager.SecretsManager, secretId string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagResource(&secretsmanager.TagResourceInput{
+				SecretId: aws.String(secretId),
+				Tags:     tags.secretsmanagerTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&secretsmanager.UntagResourceInput{
+				SecretId: aws.String(secretId),
+				TagKeys:  aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+	}
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsSecretsManager(oldTags, newTags []*secretsmanager.Tag) ([]*secretsmanager.Tag, []*secretsmanager.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*secretsmanager.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapSecretsManager(create), remove
+	o, n := secretsmanagerKeyValueTags(oldTags), secretsmanagerKeyValueTags(newTags)
+	return o.Updated(n).secretsmanagerTags(), o.Removed(n).secretsmanagerTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapSecretsManager(m map[string]interface{}) []*secretsmanager.Tag {
-	result := make([]*secretsmanager.Tag, 0, len(m))
-	for k, v := range m {
-		t := &secretsmanager.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredSecretsManager(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().secretsmanagerTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapSecretsManager(ts []*secretsmanager.Tag) map[string]string {
-	result := make(map[string]string)
+	return secretsmanagerKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// secretsmanagerKeyValueTags converts Secrets Manager tags to keyValueTags.
+func secretsmanagerKeyValueTags(ts []*secretsmanager.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredSecretsManager(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// secretsmanagerTags converts keyValueTags to Secrets Manager tags.
+func (tags keyValueTags) secretsmanagerTags() []*secretsmanager.Tag {
+	result := make([]*secretsmanager.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -62,13 +76,5 @@ func tagsToMapSecretsManager(ts []*secretsmanager.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredSecretsManager(t *secretsmanager.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setTagsWorkspaces is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsWorkspaces(conn *workspaces.WorkSpaces, d *schema.ResourceData) error {
+	return tagsUpdaterWorkspaces(conn, d.Id()).updateResourceData(d)
+}
+
+// tagsUpdaterWorkspaces returns the tagsUpdater for the tagging API of WorkSpaces.
+func tagsUpdaterWorkspaces(conn *workspaces.WorkSpaces, resourceId string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.CreateTags(&workspaces.CreateTagsInput{
+				ResourceId: aws.String(resourceId),
+				Tags:       tags.workspacesTags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.DeleteTags(&workspaces.DeleteTagsInput{
+				ResourceId: aws.String(resourceId),
+				TagKeys:    aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+	}
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapWorkspaces(m map[string]interface{}) []*workspaces.Tag {
+	return newKeyValueTags(m).IgnoreAws().workspacesTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapWorkspaces(ts []*workspaces.Tag) map[string]string {
+	return workspacesKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// workspacesKeyValueTags converts WorkSpaces tags to keyValueTags.
+func workspacesKeyValueTags(ts []*workspaces.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// workspacesTags converts keyValueTags to WorkSpaces tags.
+func (tags keyValueTags) workspacesTags() []*workspaces.Tag {
+	result := make([]*workspaces.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &workspaces.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
+}
@@ -1,44 +1,31 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func setTagsAPIGatewayStage(conn *apigateway.APIGateway, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsGeneric(o, n)
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	return tagsUpdaterAPIGateway(conn, arn).updateResourceData(d)
+}
 
-			_, err := conn.UntagResource(&apigateway.UntagResourceInput{
+// tagsUpdaterAPIGateway returns the tagsUpdater for the tagging API of API Gateway.
+func tagsUpdaterAPIGateway(conn *apigateway.APIGateway, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.TagResource(&apigateway.TagResourceInput{
 				ResourceArn: aws.String(arn),
-				TagKeys:     keys,
+				Tags:        aws.StringMap(tags.Map()),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&apigateway.TagResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.UntagResource(&apigateway.UntagResourceInput{
 				ResourceArn: aws.String(arn),
-				Tags:        create,
+				TagKeys:     aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-	return nil
 }
@@ -7,85 +7,65 @@ import (
 )
 
 func dmsTagsToMap(tags []*dms.Tag) map[string]string {
-	result := make(map[string]string)
-
-	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
-	}
-
-	return result
+	return dmsKeyValueTags(tags).Map()
 }
 
 func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
-	result := make([]*dms.Tag, 0, len(m))
-
-	for k, v := range m {
-		result = append(result, &dms.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return newKeyValueTags(m).dmsTags()
 }
 
 func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	remove := []*dms.Tag{}
-	for _, t := range oldTags {
-		v, ok := create[*t.Key]
-		if !ok || v != *t.Value {
-			remove = append(remove, t)
-		}
-	}
-
-	return dmsTagsFromMap(create), remove
+	o, n := dmsKeyValueTags(oldTags), dmsKeyValueTags(newTags)
+	return o.Updated(n).dmsTags(), o.Removed(n).dmsTags()
 }
 
 func dmsGetTagKeys(tags []*dms.Tag) []*string {
-	keys := []*string{}
-
-	for _, tag := range tags {
-		keys = append(keys, tag.Key)
-	}
-
-	return keys
+	return aws.StringSlice(dmsKeyValueTags(tags).Keys())
 }
 
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-
-		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
+	return dmsTagsUpdater(meta.(*AWSClient).dmsconn, arn).updateResourceData(d)
+}
 
-		if len(remove) > 0 {
-			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
+// dmsTagsUpdater returns the tagsUpdater for the tagging API of DMS.
+func dmsTagsUpdater(conn *dms.DatabaseMigrationService, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
 				ResourceArn: aws.String(arn),
-				TagKeys:     dmsGetTagKeys(remove),
+				Tags:        tags.dmsTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(add) > 0 {
-			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
 				ResourceArn: aws.String(arn),
-				Tags:        add,
+				TagKeys:     aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+	}
+}
+
+// dmsKeyValueTags converts DMS tags to keyValueTags.
+func dmsKeyValueTags(ts []*dms.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return nil
+	return tags
+}
+
+// dmsTags converts keyValueTags to DMS tags.
+func (tags keyValueTags) dmsTags() []*dms.Tag {
+	result := make([]*dms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dms.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
 }
@@ -75,7 +75,7 @@ func TestDmsDiffTags(t *testing.T) {
 			o: map[string]interface{}{"test-key-1": "test-value-1"},
 			n: map[string]interface{}{"test-key-1": "test-value-1-modified"},
 			a: map[string]string{"test-key-1": "test-value-1-modified"},
-			r: map[string]string{"test-key-1": "test-value-1"},
+			r: map[string]string{},
 		},
 	}
 
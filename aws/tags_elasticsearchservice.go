@@ -1,99 +1,73 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsElasticsearchService is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsElasticsearchService(tagsFromMapElasticsearchService(o), tagsFromMapElasticsearchService(n))
+	return tagsUpdaterElasticsearchService(conn, arn).updateResourceData(d)
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
-			_, err := conn.RemoveTags(&elasticsearch.RemoveTagsInput{
+// tagsUpdaterElasticsearchService returns the tagsUpdater for the tagging API of Elasticsearch Service.
+func tagsUpdaterElasticsearchService(conn *elasticsearch.ElasticsearchService, arn string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.AddTags(&elasticsearch.AddTagsInput{
 				ARN:     aws.String(arn),
-				TagKeys: k,
+				TagList: tags.elasticsearchTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.AddTags(&elasticsearch.AddTagsInput{
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.RemoveTags(&elasticsearch.RemoveTagsInput{
 				ARN:     aws.String(arn),
-				TagList: create,
+				TagKeys: aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsElasticsearchService(oldTags, newTags []*elasticsearch.Tag) ([]*elasticsearch.Tag, []*elasticsearch.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elasticsearch.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapElasticsearchService(create), remove
+	o, n := elasticsearchKeyValueTags(oldTags), elasticsearchKeyValueTags(newTags)
+	return o.Updated(n).elasticsearchTags(), o.Removed(n).elasticsearchTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapElasticsearchService(m map[string]interface{}) []*elasticsearch.Tag {
-	var result []*elasticsearch.Tag
-	for k, v := range m {
-		t := &elasticsearch.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredElasticsearchService(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().elasticsearchTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
-	result := make(map[string]string)
+	return elasticsearchKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// elasticsearchKeyValueTags converts Elasticsearch Service tags to keyValueTags.
+func elasticsearchKeyValueTags(ts []*elasticsearch.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredElasticsearchService(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// elasticsearchTags converts keyValueTags to Elasticsearch Service tags.
+func (tags keyValueTags) elasticsearchTags() []*elasticsearch.Tag {
+	result := make([]*elasticsearch.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticsearch.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -102,13 +76,5 @@ func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredElasticsearchService(t *elasticsearch.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tagIgnoreConfig holds the tag keys and key prefixes that the provider has
+// been configured to ignore, in addition to the keys reserved by AWS.
+type tagIgnoreConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// ignored reports whether changes to the tag with the given key should be
+// ignored, both when reading tags into state and when diffing them.
+func (c *tagIgnoreConfig) ignored(key string) bool {
+	if tagIgnoredAws(key) {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+
+	for _, k := range c.Keys {
+		if key == k {
+			log.Printf("[DEBUG] Ignoring tag %s (ignore_tags key %s)", key, k)
+			return true
+		}
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			log.Printf("[DEBUG] Ignoring tag %s (ignore_tags key prefix %s)", key, prefix)
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeIgnoredTags returns the tags without those that should be ignored.
+// Keys configured on the resource itself are kept, as ignore_tags is meant for
+// tags managed outside of Terraform, except for the reserved "aws:" keys.
+func (c *tagIgnoreConfig) removeIgnoredTags(tags, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if _, ok := configured[k]; ok && !tagIgnoredAws(k) {
+			result[k] = v
+			continue
+		}
+		if !c.ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// tagIgnoredAws reports whether the tag key is in the "aws:" namespace. These
+// tags are reserved for use by AWS, can't be modified and are always ignored.
+func tagIgnoredAws(key string) bool {
+	if strings.HasPrefix(key, "aws:") {
+		log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.", key)
+		return true
+	}
+	return false
+}
+
+// resourceWithDefaultTags extends a resource that manages a "tags" map so that
+// the provider-level default_tags and ignore_tags are applied to it.
+//
+// A computed "tags_all" attribute holds the full set of tags on the resource:
+// the provider default tags overridden by the resource tags. The tagging
+// helpers reconcile "tags_all" against the remote resource, while "tags"
+// only ever holds what was configured on the resource itself, so inherited
+// keys never show up as a difference between configuration and state. Tags
+// matched by ignore_tags are left out of both, so the helpers never touch them,
+// unless they are configured on the resource.
+func resourceWithDefaultTags(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap {
+		return
+	}
+
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+
+	forceNew := s.ForceNew || r.Update == nil
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+		if err := resourceTagsAllCustomizeDiff(diff, meta, forceNew); err != nil {
+			return err
+		}
+		if customizeDiff != nil {
+			return customizeDiff(diff, meta)
+		}
+		return nil
+	}
+
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			// Resources pass their tags to the create call, so hand them the full set.
+			if err := d.Set("tags", d.Get("tags_all")); err != nil {
+				return err
+			}
+			err := create(d, meta)
+			if setErr := setTagsAllState(d, meta, configured); err == nil {
+				err = setErr
+			}
+			return err
+		}
+	}
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return setTagsAllState(d, meta, configured)
+		}
+	}
+
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			err := update(d, meta)
+			if setErr := setTagsAllState(d, meta, configured); err == nil {
+				err = setErr
+			}
+			return err
+		}
+	}
+}
+
+// resourceTagsAllCustomizeDiff plans "tags_all" as the provider default tags
+// merged with the configured resource tags.
+func resourceTagsAllCustomizeDiff(diff *schema.ResourceDiff, meta interface{}, forceNew bool) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	// Don't replace resources just because the provider default tags changed.
+	if forceNew && diff.Id() != "" && !diff.HasChange("tags") {
+		return nil
+	}
+
+	tags := diff.Get("tags").(map[string]interface{})
+	tagsAll := mergeDefaultTags(providerDefaultTags(meta), tags)
+	tagsAll = providerIgnoreTags(meta).removeIgnoredTags(tagsAll, tags)
+
+	o, _ := diff.GetChange("tags_all")
+	old := o.(map[string]interface{})
+	if len(old) == 0 {
+		// State written before "tags_all" existed.
+		o, _ = diff.GetChange("tags")
+		old = o.(map[string]interface{})
+	}
+	if reflect.DeepEqual(old, tagsAll) {
+		return nil
+	}
+
+	if err := diff.SetNew("tags_all", tagsAll); err != nil {
+		return err
+	}
+	if forceNew && diff.Id() != "" {
+		return diff.ForceNew("tags_all")
+	}
+	return nil
+}
+
+// setTagsAllState records the tags read from the remote resource, less any
+// ignored tags not configured on the resource, in "tags_all" and removes the
+// keys inherited from the provider default tags from "tags". Inherited keys
+// are kept in "tags" when they were configured on the resource or when their
+// value has drifted from the default, so that the difference is planned.
+func setTagsAllState(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	tags := providerIgnoreTags(meta).removeIgnoredTags(d.Get("tags").(map[string]interface{}), configured)
+	if err := d.Set("tags_all", tags); err != nil {
+		return err
+	}
+
+	return d.Set("tags", removeDefaultTags(providerDefaultTags(meta), tags, configured))
+}
+
+// providerDefaultTags returns the default_tags configured on the provider.
+func providerDefaultTags(meta interface{}) map[string]interface{} {
+	if client, ok := meta.(*AWSClient); ok && client != nil {
+		return client.defaultTags
+	}
+	return nil
+}
+
+// providerIgnoreTags returns the ignore_tags configured on the provider.
+func providerIgnoreTags(meta interface{}) *tagIgnoreConfig {
+	if client, ok := meta.(*AWSClient); ok && client != nil {
+		return client.ignoreTags
+	}
+	return nil
+}
+
+// mergeDefaultTags returns the default tags overridden by the resource tags.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeDefaultTags returns the tags without those inherited from the default
+// tags, i.e. those not configured on the resource whose value matches the
+// default.
+func removeDefaultTags(defaultTags, tags, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if _, ok := configured[k]; !ok {
+			if dv, ok := defaultTags[k]; ok && dv == v {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Default, Tags, Expected map[string]interface{}
+	}{
+		{
+			Default:  map[string]interface{}{},
+			Tags:     map[string]interface{}{"foo": "bar"},
+			Expected: map[string]interface{}{"foo": "bar"},
+		},
+		{
+			Default:  map[string]interface{}{"env": "prod"},
+			Tags:     map[string]interface{}{},
+			Expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			Default:  map[string]interface{}{"env": "prod", "owner": "ops"},
+			Tags:     map[string]interface{}{"env": "test", "foo": "bar"},
+			Expected: map[string]interface{}{"env": "test", "owner": "ops", "foo": "bar"},
+		},
+	}
+
+	for i, tc := range cases {
+		got := mergeDefaultTags(tc.Default, tc.Tags)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, got)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	cases := []struct {
+		Default, Tags, Configured, Expected map[string]interface{}
+	}{
+		// Inherited
+		{
+			Default:    map[string]interface{}{"env": "prod"},
+			Tags:       map[string]interface{}{"env": "prod", "foo": "bar"},
+			Configured: map[string]interface{}{"foo": "bar"},
+			Expected:   map[string]interface{}{"foo": "bar"},
+		},
+		// Configured on the resource with the default value
+		{
+			Default:    map[string]interface{}{"env": "prod"},
+			Tags:       map[string]interface{}{"env": "prod"},
+			Configured: map[string]interface{}{"env": "prod"},
+			Expected:   map[string]interface{}{"env": "prod"},
+		},
+		// Drifted from the default
+		{
+			Default:    map[string]interface{}{"env": "prod"},
+			Tags:       map[string]interface{}{"env": "test"},
+			Configured: map[string]interface{}{},
+			Expected:   map[string]interface{}{"env": "test"},
+		},
+	}
+
+	for i, tc := range cases {
+		got := removeDefaultTags(tc.Default, tc.Tags, tc.Configured)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, got)
+		}
+	}
+}
+
+func TestResourceWithDefaultTags_diff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Create: func(*schema.ResourceData, interface{}) error { return nil },
+		Read:   func(*schema.ResourceData, interface{}) error { return nil },
+		Update: func(*schema.ResourceData, interface{}) error { return nil },
+		Delete: func(*schema.ResourceData, interface{}) error { return nil },
+	}
+	resourceWithDefaultTags(r)
+
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{"env": "prod"},
+	}
+
+	cases := []struct {
+		Name     string
+		State    map[string]string
+		Config   map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:   "create",
+			Config: map[string]interface{}{"tags": map[string]interface{}{"foo": "bar"}},
+			Expected: map[string]string{
+				"tags_all.%":   "2",
+				"tags_all.env": "prod",
+				"tags_all.foo": "bar",
+			},
+		},
+		{
+			Name: "inherited keys in sync",
+			State: map[string]string{
+				"tags.%":       "1",
+				"tags.foo":     "bar",
+				"tags_all.%":   "2",
+				"tags_all.env": "prod",
+				"tags_all.foo": "bar",
+			},
+			Config:   map[string]interface{}{"tags": map[string]interface{}{"foo": "bar"}},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "default changed",
+			State: map[string]string{
+				"tags.%":       "1",
+				"tags.foo":     "bar",
+				"tags_all.%":   "2",
+				"tags_all.env": "test",
+				"tags_all.foo": "bar",
+			},
+			Config: map[string]interface{}{"tags": map[string]interface{}{"foo": "bar"}},
+			Expected: map[string]string{
+				"tags_all.env": "prod",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		c, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Name, err)
+		}
+
+		var state *terraform.InstanceState
+		if tc.State != nil {
+			state = &terraform.InstanceState{ID: "foo", Attributes: tc.State}
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(c), meta)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Name, err)
+		}
+
+		got := make(map[string]string)
+		if diff != nil {
+			for k, v := range diff.Attributes {
+				if k == "tags_all.%" && v.Old == v.New {
+					continue
+				}
+				if len(k) > len("tags_all") && k[:len("tags_all")] == "tags_all" {
+					got[k] = v.New
+				}
+			}
+		}
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: bad: %#v", tc.Name, got)
+		}
+	}
+}
+
+func TestTagIgnoreConfig(t *testing.T) {
+	c := &tagIgnoreConfig{
+		Keys:        []string{"Patched"},
+		KeyPrefixes: []string{"kubernetes.io/cluster/"},
+	}
+
+	cases := []struct {
+		Key      string
+		Config   *tagIgnoreConfig
+		Expected bool
+	}{
+		{Key: "aws:cloudformation:stack-name", Config: nil, Expected: true},
+		{Key: "Name", Config: nil, Expected: false},
+		{Key: "aws:cloudformation:stack-name", Config: c, Expected: true},
+		{Key: "Patched", Config: c, Expected: true},
+		{Key: "PatchedAt", Config: c, Expected: false},
+		{Key: "kubernetes.io/cluster/foo", Config: c, Expected: true},
+		{Key: "kubernetes.io/role/elb", Config: c, Expected: false},
+		{Key: "Name", Config: c, Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.Config.ignored(tc.Key); got != tc.Expected {
+			t.Fatalf("%s: expected %t, got %t", tc.Key, tc.Expected, got)
+		}
+	}
+
+	tags := map[string]interface{}{
+		"Name":                      "foo",
+		"Patched":                   "2018-07-01",
+		"kubernetes.io/cluster/foo": "owned",
+	}
+	expected := map[string]interface{}{
+		"Name": "foo",
+	}
+	if got := c.removeIgnoredTags(tags, nil); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad: %#v", got)
+	}
+
+	tags["aws:cloudformation:stack-name"] = "stack"
+	configured := map[string]interface{}{
+		"Patched":                       "2018-07-01",
+		"aws:cloudformation:stack-name": "stack",
+	}
+	expected = map[string]interface{}{
+		"Name":    "foo",
+		"Patched": "2018-07-01",
+	}
+	if got := c.removeIgnoredTags(tags, configured); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad: %#v", got)
+	}
+}
+
+func TestResourceWithDefaultTags_ignoredConfigured(t *testing.T) {
+	remote := map[string]interface{}{
+		"Patched":                   "2018-07-01",
+		"kubernetes.io/cluster/foo": "owned",
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Create: func(*schema.ResourceData, interface{}) error { return nil },
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", remote)
+		},
+		Update: func(*schema.ResourceData, interface{}) error { return nil },
+		Delete: func(*schema.ResourceData, interface{}) error { return nil },
+	}
+	resourceWithDefaultTags(r)
+
+	meta := &AWSClient{
+		ignoreTags: &tagIgnoreConfig{
+			Keys:        []string{"Patched"},
+			KeyPrefixes: []string{"kubernetes.io/cluster/"},
+		},
+	}
+
+	// Refreshing keeps the configured key, but not the other ignored one
+	d := r.Data(&terraform.InstanceState{
+		ID: "foo",
+		Attributes: map[string]string{
+			"tags.%":           "1",
+			"tags.Patched":     "2018-07-01",
+			"tags_all.%":       "1",
+			"tags_all.Patched": "2018-07-01",
+		},
+	})
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := map[string]interface{}{"Patched": "2018-07-01"}
+	if got := d.Get("tags"); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad tags: %#v", got)
+	}
+	if got := d.Get("tags_all"); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad tags_all: %#v", got)
+	}
+
+	// Planning against the refreshed state shows no difference
+	c, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{"Patched": "2018-07-01"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfig(c), meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no diff, got: %#v", diff.Attributes)
+	}
+}
@@ -1,96 +1,76 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsR53 is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsR53(tagsFromMapR53(o), tagsFromMapR53(n))
-
-		// Set tags
-		r := make([]*string, len(remove))
-		for i, t := range remove {
-			r[i] = t.Key
-		}
-		log.Printf("[DEBUG] Changing tags: \n\tadding: %#v\n\tremoving:%#v", create, remove)
-		req := &route53.ChangeTagsForResourceInput{
-			ResourceId:   aws.String(d.Id()),
-			ResourceType: aws.String(resourceType),
-		}
-
-		if len(create) > 0 {
-			req.AddTags = create
-		}
-		if len(r) > 0 {
-			req.RemoveTagKeys = r
-		}
+	return tagsUpdaterR53(conn, d.Id(), resourceType).updateResourceData(d)
+}
 
-		_, err := conn.ChangeTagsForResource(req)
-		if err != nil {
+// tagsUpdaterR53 returns the tagsUpdater for the tagging API of Route 53.
+func tagsUpdaterR53(conn *route53.Route53, id, resourceType string) *tagsUpdater {
+	return &tagsUpdater{
+		tag: func(tags keyValueTags) error {
+			_, err := conn.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+				ResourceId:   aws.String(id),
+				ResourceType: aws.String(resourceType),
+				AddTags:      tags.route53Tags(),
+			})
+			return err
+		},
+		untag: func(tags keyValueTags) error {
+			_, err := conn.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+				ResourceId:    aws.String(id),
+				ResourceType:  aws.String(resourceType),
+				RemoveTagKeys: aws.StringSlice(tags.Keys()),
+			})
 			return err
-		}
+		},
+		batchSize: 10,
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsR53(oldTags, newTags []*route53.Tag) ([]*route53.Tag, []*route53.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*route53.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapR53(create), remove
+	o, n := route53KeyValueTags(oldTags), route53KeyValueTags(newTags)
+	return o.Updated(n).route53Tags(), o.Removed(n).route53Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
-	result := make([]*route53.Tag, 0, len(m))
-	for k, v := range m {
-		t := &route53.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRoute53(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().route53Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapR53(ts []*route53.Tag) map[string]string {
-	result := make(map[string]string)
+	return route53KeyValueTags(ts).IgnoreAws().Map()
+}
+
+// route53KeyValueTags converts Route 53 tags to keyValueTags.
+func route53KeyValueTags(ts []*route53.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredRoute53(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// route53Tags converts keyValueTags to Route 53 tags.
+func (tags keyValueTags) route53Tags() []*route53.Tag {
+	result := make([]*route53.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &route53.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -99,13 +79,5 @@ func tagsToMapR53(ts []*route53.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredRoute53(t *route53.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
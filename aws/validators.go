@@ -1863,3 +1863,20 @@ func validateNeptuneParamGroupNamePrefix(v interface{}, k string) (ws []string,
 	}
 	return
 }
+
+func validateSagemakerName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and hyphens allowed in %q: %q", k, value))
+	}
+	if regexp.MustCompile(`^-|-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot begin or end with a hyphen: %q", k, value))
+	}
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 63 characters: %q", k, value))
+	}
+	return
+}
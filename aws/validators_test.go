@@ -2730,3 +2730,34 @@ func TestValidateNeptuneParamGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSagemakerName(t *testing.T) {
+	validNames := []string{
+		"ValidSageMakerName",
+		"Valid-5a63Mak3r-Name",
+		"123-456-789",
+		"1234",
+		strings.Repeat("W", 63),
+	}
+	for _, v := range validNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"Invalid name",
+		"-InvalidName",
+		"InvalidName-",
+		"Invalid_Name",
+		"Invalid.Name",
+		strings.Repeat("W", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name", v)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -362,3 +363,15 @@ func resourceAwsWafRegexMatchSetTupleHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+var wafResourceIdRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// resourceAwsWafImport is shared by the WAF and WAF Regional resources,
+// all of which are identified by the ID returned from their Create call.
+func resourceAwsWafImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !wafResourceIdRegexp.MatchString(d.Id()) {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected a WAF resource ID (e.g. a1b2c3d4-5678-90ab-cdef-0123456789ab)", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}